@@ -2,10 +2,10 @@ package helium
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 // Account handles api endpoint /accounts docs located at https://docs.helium.com/api/blockchain/accounts
@@ -28,14 +28,14 @@ type UserAccount struct {
 }
 
 type AccountData struct {
-	SecNonce   int    `json:"sec_nonce"`
-	SecBalance int    `json:"sec_balance"`
-	Nonce      int    `json:"nonce"`
-	DcNonce    int    `json:"dc_nonce"`
-	DcBalance  int    `json:"dc_balance"`
-	Block      int    `json:"block"`
-	Balance    int    `json:"balance"`
-	Address    string `json:"address"`
+	SecNonce   int     `json:"sec_nonce"`
+	SecBalance HST     `json:"sec_balance"`
+	Nonce      int     `json:"nonce"`
+	DcNonce    int     `json:"dc_nonce"`
+	DcBalance  DC      `json:"dc_balance"`
+	Block      int     `json:"block"`
+	Balance    HNT     `json:"balance"`
+	Address    Address `json:"address"`
 }
 
 type Status struct {
@@ -56,45 +56,26 @@ type Geocode struct {
 	CityID       string `json:"city_id"`
 }
 
-type Ouis struct {
-	Data   []OuiData `json:"data"`
-	Cursor string    `json:"cursor"`
-}
-
-type Subnets struct {
-	Mask int `json:"mask"`
-	Base int `json:"base"`
-}
-
-type OuiData struct {
-	Subnets   []Subnets `json:"subnets"`
-	Owner     string    `json:"owner"`
-	Oui       int       `json:"oui"`
-	Nonce     int       `json:"nonce"`
-	Block     int       `json:"block"`
-	Addresses []string  `json:"addresses"`
-}
-
 type Activity struct {
 	Data   []ActivityData `json:"data"`
 	Cursor string         `json:"cursor"`
 }
 
-type Reward struct {
-	Type    string `json:"type"`
-	Gateway string `json:"gateway"`
-	Amount  int    `json:"amount"`
-	Account string `json:"account"`
+type RewardEntry struct {
+	Type    string  `json:"type"`
+	Gateway Address `json:"gateway"`
+	Amount  HNT     `json:"amount"`
+	Account Address `json:"account"`
 }
 
 type ActivityData struct {
-	Type       string   `json:"type"`
-	Time       int      `json:"time"`
-	StartEpoch int      `json:"start_epoch"`
-	Rewards    []Reward `json:"rewards"`
-	Height     int      `json:"height"`
-	Hash       string   `json:"hash"`
-	EndEpoch   int      `json:"end_epoch"`
+	Type       string        `json:"type"`
+	Time       Timestamp     `json:"time"`
+	StartEpoch int           `json:"start_epoch"`
+	Rewards    []RewardEntry `json:"rewards"`
+	Height     int           `json:"height"`
+	Hash       string        `json:"hash"`
+	EndEpoch   int           `json:"end_epoch"`
 }
 
 type ActivityCount struct {
@@ -136,13 +117,13 @@ type Elections struct {
 }
 
 type ElectionData struct {
-	Type    string   `json:"type"`
-	Time    int      `json:"time"`
-	Proof   string   `json:"proof"`
-	Members []string `json:"members"`
-	Height  int      `json:"height"`
-	Hash    string   `json:"hash"`
-	Delay   int      `json:"delay"`
+	Type    string    `json:"type"`
+	Time    Timestamp `json:"time"`
+	Proof   string    `json:"proof"`
+	Members []Address `json:"members"`
+	Height  int       `json:"height"`
+	Hash    string    `json:"hash"`
+	Delay   int       `json:"delay"`
 }
 
 type Challenges struct {
@@ -151,25 +132,25 @@ type Challenges struct {
 }
 
 type Witness struct {
-	Timestamp  int64   `json:"timestamp"`
-	Snr        int     `json:"snr"`
-	Signal     int     `json:"signal"`
-	PacketHash string  `json:"packet_hash"`
-	Owner      string  `json:"owner"`
-	Location   string  `json:"location"`
-	IsValid    bool    `json:"is_valid"`
-	Gateway    string  `json:"gateway"`
-	Frequency  float64 `json:"frequency"`
-	Datarate   string  `json:"datarate"`
-	Channel    int     `json:"channel"`
+	Timestamp  Timestamp `json:"timestamp"`
+	Snr        int       `json:"snr"`
+	Signal     int       `json:"signal"`
+	PacketHash string    `json:"packet_hash"`
+	Owner      Address   `json:"owner"`
+	Location   string    `json:"location"`
+	IsValid    bool      `json:"is_valid"`
+	Gateway    Address   `json:"gateway"`
+	Frequency  float64   `json:"frequency"`
+	Datarate   string    `json:"datarate"`
+	Channel    int       `json:"channel"`
 }
 
 type Receipt struct {
-	Timestamp int64       `json:"timestamp"`
+	Timestamp Timestamp   `json:"timestamp"`
 	Snr       int         `json:"snr"`
 	Signal    int         `json:"signal"`
 	Origin    string      `json:"origin"`
-	Gateway   string      `json:"gateway"`
+	Gateway   Address     `json:"gateway"`
 	Frequency int         `json:"frequency"`
 	Datarate  interface{} `json:"datarate"`
 	Data      string      `json:"data"`
@@ -180,28 +161,28 @@ type Path struct {
 	Witnesses          []Witness `json:"witnesses"`
 	Receipt            Receipt   `json:"receipt"`
 	Geocode            Geocode   `json:"geocode"`
-	ChallengeeOwner    string    `json:"challengee_owner"`
+	ChallengeeOwner    Address   `json:"challengee_owner"`
 	ChallengeeLon      float64   `json:"challengee_lon"`
 	ChallengeeLocation string    `json:"challengee_location"`
 	ChallengeeLat      float64   `json:"challengee_lat"`
-	Challengee         string    `json:"challengee"`
+	Challengee         Address   `json:"challengee"`
 }
 
 type ChallengeData struct {
-	Type               string  `json:"type"`
-	Time               int     `json:"time"`
-	Secret             string  `json:"secret"`
-	RequestBlockHash   string  `json:"request_block_hash"`
-	Path               []Path  `json:"path"`
-	OnionKeyHash       string  `json:"onion_key_hash"`
-	Height             int     `json:"height"`
-	Hash               string  `json:"hash"`
-	Fee                int     `json:"fee"`
-	ChallengerOwner    string  `json:"challenger_owner"`
-	ChallengerLon      float64 `json:"challenger_lon"`
-	ChallengerLocation string  `json:"challenger_location"`
-	ChallengerLat      float64 `json:"challenger_lat"`
-	Challenger         string  `json:"challenger"`
+	Type               string    `json:"type"`
+	Time               Timestamp `json:"time"`
+	Secret             string    `json:"secret"`
+	RequestBlockHash   string    `json:"request_block_hash"`
+	Path               []Path    `json:"path"`
+	OnionKeyHash       string    `json:"onion_key_hash"`
+	Height             int       `json:"height"`
+	Hash               string    `json:"hash"`
+	Fee                DC        `json:"fee"`
+	ChallengerOwner    Address   `json:"challenger_owner"`
+	ChallengerLon      float64   `json:"challenger_lon"`
+	ChallengerLocation string    `json:"challenger_location"`
+	ChallengerLat      float64   `json:"challenger_lat"`
+	Challenger         Address   `json:"challenger"`
 }
 
 type AccountPendingTransactions struct {
@@ -210,40 +191,47 @@ type AccountPendingTransactions struct {
 }
 
 type Payments struct {
-	Amount int    `json:"amount"`
-	Payee  string `json:"payee"`
-}
-
-type Txn struct {
-	Fee       int        `json:"fee"`
-	Nonce     int        `json:"nonce"`
-	Payer     string     `json:"payer"`
-	Payments  []Payments `json:"payments"`
-	Signature string     `json:"signature"`
+	Amount HNT     `json:"amount"`
+	Payee  Address `json:"payee"`
+	Memo   string  `json:"memo,omitempty"`
 }
 
 type AccountPendingTransactionData struct {
-	CreatedAt    time.Time `json:"created_at"`
+	CreatedAt    Timestamp `json:"created_at"`
 	FailedReason string    `json:"failed_reason"`
 	Hash         string    `json:"hash"`
 	Status       string    `json:"status"`
 	Txn          Txn       `json:"txn"`
-	Type         string    `json:"type"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	Type         TxnType   `json:"type"`
+	UpdatedAt    Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes Txn into the concrete type named by Type
+func (d *AccountPendingTransactionData) UnmarshalJSON(data []byte) error {
+	type alias AccountPendingTransactionData
+	aux := struct {
+		*alias
+		Txn json.RawMessage `json:"txn"`
+	}{alias: (*alias)(d)}
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+	d.Txn, err = decodePendingTxn(d.Type, d.Hash, aux.Txn)
+	return err
 }
 
 type Rewards struct {
-	Data []RewardData `json:"data"`
+	Data   []RewardData `json:"data"`
+	Cursor string       `json:"cursor"`
 }
 
+// RewardData is a reward paid to an account or hotspot, Block and Hash are of the rewards transaction that paid it
 type RewardData struct {
-	CreatedAt    time.Time `json:"created_at"`
-	FailedReason string    `json:"failed_reason"`
-	Hash         string    `json:"hash"`
-	Status       string    `json:"status"`
-	Txn          Txn       `json:"txn"`
-	Type         string    `json:"type"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	RewardEntry
+	Block     int       `json:"block"`
+	Hash      string    `json:"hash"`
+	Timestamp Timestamp `json:"timestamp"`
 }
 
 type RewardSum struct {
@@ -251,9 +239,9 @@ type RewardSum struct {
 }
 
 type RewardSumData struct {
-	MaxTime time.Time `json:"max_time"`
-	MinTime time.Time `json:"min_time"`
-	Sum     string    `json:"sum"`
+	MaxTime Timestamp `json:"max_time"`
+	MinTime Timestamp `json:"min_time"`
+	Sum     HNT       `json:"sum"`
 }
 
 type AccountStats struct {
@@ -261,18 +249,18 @@ type AccountStats struct {
 }
 
 type LastWeek struct {
-	Timestamp time.Time `json:"timestamp"`
-	Balance   int64     `json:"balance"`
+	Timestamp Timestamp `json:"timestamp"`
+	Balance   HNT       `json:"balance"`
 }
 
 type LastMonth struct {
-	Timestamp time.Time `json:"timestamp"`
-	Balance   int64     `json:"balance"`
+	Timestamp Timestamp `json:"timestamp"`
+	Balance   HNT       `json:"balance"`
 }
 
 type LastDay struct {
-	Timestamp time.Time `json:"timestamp"`
-	Balance   int64     `json:"balance"`
+	Timestamp Timestamp `json:"timestamp"`
+	Balance   HNT       `json:"balance"`
 }
 
 type AccountStatsData struct {
@@ -290,14 +278,38 @@ type AccountRichestInput struct {
 }
 
 type AccountInput struct {
-	ID string
+	ID     Address
+	Cursor string
+}
+
+// AccountActivityInput narrows the activity or roles of an account to transaction types and a time window,
+// Limit caps the number of transactions per page
+type AccountActivityInput struct {
+	ID          Address
+	FilterTypes []TxnType
+	MinTime     TimeBound
+	MaxTime     TimeBound
+	Limit       int
+	Cursor      string
+}
+
+type AccountRewardsInput struct {
+	ID      Address
+	MinTime TimeBound
+	MaxTime TimeBound
+	Cursor  string
 }
 
 // List Retrieves the current set of known accounts
 func (a *Account) List(input *AccountListInput) (*Accounts, error) {
+	return a.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (a *Account) ListWithContext(ctx context.Context, input *AccountListInput) (*Accounts, error) {
 	params := make(map[string]string)
 	params["cursor"] = input.Cursor
-	resp, err := a.c.Request(http.MethodGet, "/accounts", new(bytes.Buffer), params)
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, "/accounts", new(bytes.Buffer), params)
 	if err != nil {
 		return &Accounts{}, err
 	}
@@ -311,13 +323,25 @@ func (a *Account) List(input *AccountListInput) (*Accounts, error) {
 	return accounts, nil
 }
 
+// ListAll iterates over every known account.
+func (a *Account) ListAll(opts ...PagerOption) *AccountPager {
+	return NewAccountPager(func(ctx context.Context, cursor string) (*Accounts, error) {
+		return a.ListWithContext(ctx, &AccountListInput{Cursor: cursor})
+	}, opts...)
+}
+
 // Richest Returns up to 100 of the accounts sorted by highest token balance.
 func (a *Account) Richest(input *AccountRichestInput) (*Accounts, error) {
+	return a.RichestWithContext(context.Background(), input)
+}
+
+// RichestWithContext is Richest with a caller supplied context.
+func (a *Account) RichestWithContext(ctx context.Context, input *AccountRichestInput) (*Accounts, error) {
 	params := make(map[string]string)
 	if input.Limit > 0 {
 		params["limit"] = fmt.Sprintf("%v", input.Limit)
 	}
-	resp, err := a.c.Request(http.MethodGet, "/accounts/rich", new(bytes.Buffer), params)
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, "/accounts/rich", new(bytes.Buffer), params)
 	if err != nil {
 		return &Accounts{}, err
 	}
@@ -333,7 +357,16 @@ func (a *Account) Richest(input *AccountRichestInput) (*Accounts, error) {
 
 // Get Retrieve a specific account record.
 func (a *Account) Get(input *AccountInput) (*UserAccount, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s", input.ID), new(bytes.Buffer), nil)
+	return a.GetWithContext(context.Background(), input)
+}
+
+// GetWithContext is Get with a caller supplied context.
+func (a *Account) GetWithContext(ctx context.Context, input *AccountInput) (*UserAccount, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &UserAccount{}, err
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s", input.ID), new(bytes.Buffer), nil)
 	if err != nil {
 		return &UserAccount{}, err
 	}
@@ -349,7 +382,20 @@ func (a *Account) Get(input *AccountInput) (*UserAccount, error) {
 
 // Hotspots Fetches hotspots owned by a given account address.
 func (a *Account) Hotspots(input *AccountInput) (*Hotspots, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/hotspots", input.ID), new(bytes.Buffer), nil)
+	return a.HotspotsWithContext(context.Background(), input)
+}
+
+// HotspotsWithContext is Hotspots with a caller supplied context.
+func (a *Account) HotspotsWithContext(ctx context.Context, input *AccountInput) (*Hotspots, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &Hotspots{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/hotspots", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &Hotspots{}, err
 	}
@@ -363,9 +409,31 @@ func (a *Account) Hotspots(input *AccountInput) (*Hotspots, error) {
 	return hotspots, nil
 }
 
+// HotspotsAll iterates over every hotspot owned by a given account address.
+func (a *Account) HotspotsAll(input *AccountInput, opts ...PagerOption) *HotspotPager {
+	return NewHotspotPager(func(ctx context.Context, cursor string) (*Hotspots, error) {
+		page := *input
+		page.Cursor = cursor
+		return a.HotspotsWithContext(ctx, &page)
+	}, opts...)
+}
+
 // Ouis Fetches OUIs owned by a given account address.
 func (a *Account) Ouis(input *AccountInput) (*Ouis, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/ouis", input.ID), new(bytes.Buffer), nil)
+	return a.OuisWithContext(context.Background(), input)
+}
+
+// OuisWithContext is Ouis with a caller supplied context.
+func (a *Account) OuisWithContext(ctx context.Context, input *AccountInput) (*Ouis, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &Ouis{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/ouis", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &Ouis{}, err
 	}
@@ -379,9 +447,32 @@ func (a *Account) Ouis(input *AccountInput) (*Ouis, error) {
 	return ouis, nil
 }
 
+// OuisAll iterates over every OUI owned by a given account address.
+func (a *Account) OuisAll(input *AccountInput, opts ...PagerOption) *OuiPager {
+	return NewOuiPager(func(ctx context.Context, cursor string) (*Ouis, error) {
+		page := *input
+		page.Cursor = cursor
+		return a.OuisWithContext(ctx, &page)
+	}, opts...)
+}
+
 // Activity Fetches transactions that indicate activity for an account.
-func (a *Account) Activity(input *AccountInput) (*Activity, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/activity", input.ID), new(bytes.Buffer), nil)
+func (a *Account) Activity(input *AccountActivityInput) (*Activity, error) {
+	return a.ActivityWithContext(context.Background(), input)
+}
+
+// ActivityWithContext is Activity with a caller supplied context.
+func (a *Account) ActivityWithContext(ctx context.Context, input *AccountActivityInput) (*Activity, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &Activity{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	activityParams(params, input.FilterTypes, input.MinTime, input.MaxTime, input.Limit)
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/activity", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &Activity{}, err
 	}
@@ -395,9 +486,66 @@ func (a *Account) Activity(input *AccountInput) (*Activity, error) {
 	return activity, nil
 }
 
+// ActivityAll iterates over every transaction that indicates activity for an account.
+func (a *Account) ActivityAll(input *AccountActivityInput, opts ...PagerOption) *ActivityPager {
+	return NewActivityPager(func(ctx context.Context, cursor string) (*Activity, error) {
+		page := *input
+		page.Cursor = cursor
+		return a.ActivityWithContext(ctx, &page)
+	}, opts...)
+}
+
+// Roles Fetches the transactions an account took part in and its role in each.
+func (a *Account) Roles(input *AccountActivityInput) (*Roles, error) {
+	return a.RolesWithContext(context.Background(), input)
+}
+
+// RolesWithContext is Roles with a caller supplied context.
+func (a *Account) RolesWithContext(ctx context.Context, input *AccountActivityInput) (*Roles, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &Roles{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	activityParams(params, input.FilterTypes, input.MinTime, input.MaxTime, input.Limit)
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/roles", input.ID), new(bytes.Buffer), params)
+	if err != nil {
+		return &Roles{}, err
+	}
+	defer resp.Body.Close()
+
+	var roles *Roles
+	err = json.NewDecoder(resp.Body).Decode(&roles)
+	if err != nil {
+		return &Roles{}, err
+	}
+	return roles, nil
+}
+
+// RolesAll iterates over every transaction an account took part in.
+func (a *Account) RolesAll(input *AccountActivityInput, opts ...PagerOption) *RolePager {
+	return NewRolePager(func(ctx context.Context, cursor string) (*Roles, error) {
+		page := *input
+		page.Cursor = cursor
+		return a.RolesWithContext(ctx, &page)
+	}, opts...)
+}
+
 // ActivityCount Count transactions that indicate activity for an account.
 func (a *Account) ActivityCount(input *AccountInput) (*ActivityCount, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/activity/count", input.ID), new(bytes.Buffer), nil)
+	return a.ActivityCountWithContext(context.Background(), input)
+}
+
+// ActivityCountWithContext is ActivityCount with a caller supplied context.
+func (a *Account) ActivityCountWithContext(ctx context.Context, input *AccountInput) (*ActivityCount, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &ActivityCount{}, err
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/activity/count", input.ID), new(bytes.Buffer), nil)
 	if err != nil {
 		return &ActivityCount{}, err
 	}
@@ -413,7 +561,20 @@ func (a *Account) ActivityCount(input *AccountInput) (*ActivityCount, error) {
 
 // Elections Fetches elections that hotspots for the given account are elected in.
 func (a *Account) Elections(input *AccountInput) (*Elections, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/elections", input.ID), new(bytes.Buffer), nil)
+	return a.ElectionsWithContext(context.Background(), input)
+}
+
+// ElectionsWithContext is Elections with a caller supplied context.
+func (a *Account) ElectionsWithContext(ctx context.Context, input *AccountInput) (*Elections, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &Elections{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/elections", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &Elections{}, err
 	}
@@ -427,9 +588,31 @@ func (a *Account) Elections(input *AccountInput) (*Elections, error) {
 	return elections, nil
 }
 
+// ElectionsAll iterates over every election that hotspots for the given account are elected in.
+func (a *Account) ElectionsAll(input *AccountInput, opts ...PagerOption) *ElectionPager {
+	return NewElectionPager(func(ctx context.Context, cursor string) (*Elections, error) {
+		page := *input
+		page.Cursor = cursor
+		return a.ElectionsWithContext(ctx, &page)
+	}, opts...)
+}
+
 // Challenges Fetches challenges that hotspots owned by the given account are involved in as a challenger, challengee, or witness.
 func (a *Account) Challenges(input *AccountInput) (*Challenges, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/challenges", input.ID), new(bytes.Buffer), nil)
+	return a.ChallengesWithContext(context.Background(), input)
+}
+
+// ChallengesWithContext is Challenges with a caller supplied context.
+func (a *Account) ChallengesWithContext(ctx context.Context, input *AccountInput) (*Challenges, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &Challenges{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/challenges", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &Challenges{}, err
 	}
@@ -443,9 +626,31 @@ func (a *Account) Challenges(input *AccountInput) (*Challenges, error) {
 	return challenges, nil
 }
 
+// ChallengesAll iterates over every challenge that hotspots owned by the given account are involved in.
+func (a *Account) ChallengesAll(input *AccountInput, opts ...PagerOption) *ChallengePager {
+	return NewChallengePager(func(ctx context.Context, cursor string) (*Challenges, error) {
+		page := *input
+		page.Cursor = cursor
+		return a.ChallengesWithContext(ctx, &page)
+	}, opts...)
+}
+
 // PendingTransactions Fetches the outstanding transactions for the given account.
 func (a *Account) PendingTransactions(input *AccountInput) (*PendingTransactions, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/pending_transactions", input.ID), new(bytes.Buffer), nil)
+	return a.PendingTransactionsWithContext(context.Background(), input)
+}
+
+// PendingTransactionsWithContext is PendingTransactions with a caller supplied context.
+func (a *Account) PendingTransactionsWithContext(ctx context.Context, input *AccountInput) (*PendingTransactions, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &PendingTransactions{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/pending_transactions", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &PendingTransactions{}, err
 	}
@@ -459,9 +664,32 @@ func (a *Account) PendingTransactions(input *AccountInput) (*PendingTransactions
 	return pendingTransactions, nil
 }
 
+// PendingTransactionsAll iterates over every outstanding transaction for the given account.
+func (a *Account) PendingTransactionsAll(input *AccountInput, opts ...PagerOption) *PendingTransactionPager {
+	return NewPendingTransactionPager(func(ctx context.Context, cursor string) (*PendingTransactions, error) {
+		page := *input
+		page.Cursor = cursor
+		return a.PendingTransactionsWithContext(ctx, &page)
+	}, opts...)
+}
+
 // Rewards Returns reward entries by block and gateway for a given account in a timeframe.
-func (a *Account) Rewards(input *AccountInput) (*Rewards, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/rewards", input.ID), new(bytes.Buffer), nil)
+func (a *Account) Rewards(input *AccountRewardsInput) (*Rewards, error) {
+	return a.RewardsWithContext(context.Background(), input)
+}
+
+// RewardsWithContext is Rewards with a caller supplied context.
+func (a *Account) RewardsWithContext(ctx context.Context, input *AccountRewardsInput) (*Rewards, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &Rewards{}, err
+	}
+	params := make(map[string]string)
+	timeParams(params, input.MinTime, input.MaxTime)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/rewards", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &Rewards{}, err
 	}
@@ -475,9 +703,29 @@ func (a *Account) Rewards(input *AccountInput) (*Rewards, error) {
 	return rewards, nil
 }
 
-//RewardSum Returns the total rewards for a given account in a given timeframe.
-func (a *Account) RewardSum(input *AccountInput) (*RewardSum, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/rewards/sum", input.ID), new(bytes.Buffer), nil)
+// RewardsAll iterates over every reward entry for a given account.
+func (a *Account) RewardsAll(input *AccountRewardsInput, opts ...PagerOption) *RewardPager {
+	return NewRewardPager(func(ctx context.Context, cursor string) (*Rewards, error) {
+		page := *input
+		page.Cursor = cursor
+		return a.RewardsWithContext(ctx, &page)
+	}, opts...)
+}
+
+// RewardSum Returns the total rewards for a given account in a given timeframe.
+func (a *Account) RewardSum(input *AccountRewardsInput) (*RewardSum, error) {
+	return a.RewardSumWithContext(context.Background(), input)
+}
+
+// RewardSumWithContext is RewardSum with a caller supplied context.
+func (a *Account) RewardSumWithContext(ctx context.Context, input *AccountRewardsInput) (*RewardSum, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &RewardSum{}, err
+	}
+	params := make(map[string]string)
+	timeParams(params, input.MinTime, input.MaxTime)
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/rewards/sum", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &RewardSum{}, err
 	}
@@ -491,9 +739,48 @@ func (a *Account) RewardSum(input *AccountInput) (*RewardSum, error) {
 	return rewardSum, nil
 }
 
+// RewardSumBuckets Returns the rewards for a given account in a given timeframe, summed per bucket.
+func (a *Account) RewardSumBuckets(input *AccountRewardsInput, bucket Bucket) (*RewardBuckets, error) {
+	return a.RewardSumBucketsWithContext(context.Background(), input, bucket)
+}
+
+// RewardSumBucketsWithContext is RewardSumBuckets with a caller supplied context.
+func (a *Account) RewardSumBucketsWithContext(ctx context.Context, input *AccountRewardsInput, bucket Bucket) (*RewardBuckets, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	params := make(map[string]string)
+	err = bucketParams(params, input.MinTime, input.MaxTime, bucket)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/rewards/sum", input.ID), new(bytes.Buffer), params)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	defer resp.Body.Close()
+
+	var rewardBuckets *RewardBuckets
+	err = json.NewDecoder(resp.Body).Decode(&rewardBuckets)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	return rewardBuckets, nil
+}
+
 // Stats Fetches account statistics for a given account.
 func (a *Account) Stats(input *AccountInput) (*AccountStats, error) {
-	resp, err := a.c.Request(http.MethodGet, fmt.Sprintf("/accounts/%s/stats", input.ID), new(bytes.Buffer), nil)
+	return a.StatsWithContext(context.Background(), input)
+}
+
+// StatsWithContext is Stats with a caller supplied context.
+func (a *Account) StatsWithContext(ctx context.Context, input *AccountInput) (*AccountStats, error) {
+	err := input.ID.Validate()
+	if err != nil {
+		return &AccountStats{}, err
+	}
+	resp, err := a.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/stats", input.ID), new(bytes.Buffer), nil)
 	if err != nil {
 		return &AccountStats{}, err
 	}
@@ -1,15 +1,27 @@
-package helium
+package helium_test
 
 import (
+	"os"
 	"testing"
 
+	helium "github.com/dougkirkley/helium-go"
+	"github.com/dougkirkley/helium-go/heliumtest"
 	"github.com/stretchr/testify/assert"
 )
 
+var server *heliumtest.Server
+
+func TestMain(m *testing.M) {
+	server = heliumtest.NewServer()
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
 func TestAccountList(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	accounts, err := account.List(&AccountListInput{})
+	accounts, err := account.List(&helium.AccountListInput{})
 	if err != nil {
 		t.Error(err)
 	}
@@ -17,9 +29,9 @@ func TestAccountList(t *testing.T) {
 }
 
 func TestAccountRichest(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	input := &AccountRichestInput{
+	input := &helium.AccountRichestInput{
 		Limit: 5,
 	}
 	accounts, err := account.Richest(input)
@@ -30,22 +42,22 @@ func TestAccountRichest(t *testing.T) {
 }
 
 func TestAccountGet(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	input := &AccountInput{
+	input := &helium.AccountInput{
 		ID: "13WRNw4fmssJBvMqMnREwe1eCvUVXfnWXSXGcWXyVvAnQUF3D9R",
 	}
 	accounts, err := account.Get(input)
 	if err != nil {
 		t.Error(err)
 	}
-	assert.Equal(t, "13WRNw4fmssJBvMqMnREwe1eCvUVXfnWXSXGcWXyVvAnQUF3D9R", accounts.Data.Address)
+	assert.Equal(t, heliumtest.RichAccount, accounts.Data.Address)
 }
 
 func TestAccountHotspots(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	input := &AccountInput{
+	input := &helium.AccountInput{
 		ID: "13WRNw4fmssJBvMqMnREwe1eCvUVXfnWXSXGcWXyVvAnQUF3D9R",
 	}
 	hotspots, err := account.Hotspots(input)
@@ -56,9 +68,9 @@ func TestAccountHotspots(t *testing.T) {
 }
 
 func TestAccountOuis(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	input := &AccountInput{
+	input := &helium.AccountInput{
 		ID: "13tyMLKRFYURNBQqLSqNJg9k41maP1A7Bh8QYxR13oWv7EnFooc",
 	}
 	ouis, err := account.Ouis(input)
@@ -69,9 +81,9 @@ func TestAccountOuis(t *testing.T) {
 }
 
 func TestAccountActivity(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	input := &AccountInput{
+	input := &helium.AccountActivityInput{
 		ID: "13GCcF7oGb6waFBzYDMmydmXx4vNDUZGX4LE3QUh8eSBG53s5bx",
 	}
 	activity, err := account.Activity(input)
@@ -82,9 +94,9 @@ func TestAccountActivity(t *testing.T) {
 }
 
 func TestAccountActivityCount(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	input := &AccountInput{
+	input := &helium.AccountInput{
 		ID: "13GCcF7oGb6waFBzYDMmydmXx4vNDUZGX4LE3QUh8eSBG53s5bx",
 	}
 	activityCount, err := account.ActivityCount(input)
@@ -95,9 +107,9 @@ func TestAccountActivityCount(t *testing.T) {
 }
 
 func TestAccountElections(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	input := &AccountInput{
+	input := &helium.AccountInput{
 		ID: "146MwmL9eJJCdrykbgdL3dobdChP4Ut34mCZMR3Hv9HXTeBJQzC",
 	}
 	elections, err := account.Elections(input)
@@ -109,9 +121,9 @@ func TestAccountElections(t *testing.T) {
 }
 
 func TestAccountChallenges(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	input := &AccountInput{
+	input := &helium.AccountInput{
 		ID: "146MwmL9eJJCdrykbgdL3dobdChP4Ut34mCZMR3Hv9HXTeBJQzC",
 	}
 	activityCount, err := account.Challenges(input)
@@ -122,9 +134,9 @@ func TestAccountChallenges(t *testing.T) {
 }
 
 func TestAccountPendingTransactions(t *testing.T) {
-	client := DefaultClient()
+	client := server.Client()
 	account := client.Account()
-	input := &AccountInput{
+	input := &helium.AccountInput{
 		ID: "13GCcF7oGb6waFBzYDMmydmXx4vNDUZGX4LE3QUh8eSBG53s5bx",
 	}
 	activityCount, err := account.ActivityCount(input)
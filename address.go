@@ -0,0 +1,133 @@
+package helium
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dougkirkley/helium-go/internal/base58"
+)
+
+// ErrInvalidAddress matches errors for addresses that are not valid B58Check encoded Helium keys
+var ErrInvalidAddress = errors.New("helium: invalid address")
+
+// Address is a B58Check encoded Helium address of an account, hotspot or validator. It is a string
+// so literals can be used directly, use ParseAddress or Validate to check one before use.
+type Address string
+
+// AddressError describes an address that failed validation
+type AddressError struct {
+	Address string
+	Err     error
+}
+
+func (e *AddressError) Error() string {
+	return fmt.Sprintf("helium: invalid address %q: %v", e.Address, e.Err)
+}
+
+// Unwrap returns the underlying decoding error
+func (e *AddressError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches ErrInvalidAddress
+func (e *AddressError) Is(target error) bool {
+	return target == ErrInvalidAddress
+}
+
+// ParseAddress parses and validates a B58 address
+func ParseAddress(s string) (Address, error) {
+	a := Address(s)
+	err := a.Validate()
+	if err != nil {
+		return "", err
+	}
+	return a, nil
+}
+
+// Validate checks the address's checksum, version and key type, the returned error matches ErrInvalidAddress
+func (a Address) Validate() error {
+	_, err := a.decode()
+	return err
+}
+
+func (a Address) decode() ([]byte, error) {
+	if a == "" {
+		return nil, &AddressError{Address: string(a), Err: errors.New("empty address")}
+	}
+	version, payload, err := base58.CheckDecode(string(a))
+	if err != nil {
+		return nil, &AddressError{Address: string(a), Err: err}
+	}
+	if version != 0 {
+		return nil, &AddressError{Address: string(a), Err: fmt.Errorf("unsupported version %d", version)}
+	}
+	if len(payload) != 33 {
+		return nil, &AddressError{Address: string(a), Err: fmt.Errorf("public key is %d bytes", len(payload))}
+	}
+	switch KeyType(payload[0] & 0x0f) {
+	case KeyTypeEd25519, KeyTypeECCCompact:
+	default:
+		return nil, &AddressError{Address: string(a), Err: ErrKeyType}
+	}
+	return payload, nil
+}
+
+// Version returns the B58Check version byte, zero for a valid address
+func (a Address) Version() byte {
+	b, err := base58.Decode(string(a))
+	if err != nil || len(b) == 0 {
+		return 0
+	}
+	return b[0]
+}
+
+// KeyType returns the key type of a valid address
+func (a Address) KeyType() KeyType {
+	b, err := a.decode()
+	if err != nil {
+		return 0
+	}
+	return KeyType(b[0] & 0x0f)
+}
+
+// Network returns the network of a valid address
+func (a Address) Network() Network {
+	b, err := a.decode()
+	if err != nil {
+		return 0
+	}
+	return Network(b[0] & 0xf0)
+}
+
+// Bytes returns the binary form of the address used in transactions, the key tag byte followed by
+// the public key, or nil if the address is not valid
+func (a Address) Bytes() []byte {
+	b, err := a.decode()
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// String returns the address as a string
+func (a Address) String() string {
+	return string(a)
+}
+
+// UnmarshalJSON decodes an address as a plain string, an empty string or null leaves it empty. Addresses
+// in responses are not validated, so multisig addresses or keys of types this package does not know still
+// decode, call Validate to check one.
+func (a *Address) UnmarshalJSON(data []byte) error {
+	var s *string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		*a = ""
+		return nil
+	}
+	*a = Address(*s)
+	return nil
+}
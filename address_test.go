@@ -0,0 +1,68 @@
+package helium
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dougkirkley/helium-go/internal/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddress(t *testing.T) {
+	a, err := ParseAddress(testAccount)
+	assert.NoError(t, err)
+	assert.Equal(t, Address(testAccount), a)
+	assert.Equal(t, byte(0), a.Version())
+	assert.Equal(t, KeyTypeEd25519, a.KeyType())
+	assert.Equal(t, Mainnet, a.Network())
+	assert.Len(t, a.Bytes(), 33)
+
+	k, err := GenerateKeypair(KeyTypeECCCompact, Testnet)
+	if assert.NoError(t, err) {
+		assert.Equal(t, KeyTypeECCCompact, k.Address().KeyType())
+		assert.Equal(t, Testnet, k.Address().Network())
+		assert.Equal(t, k.PublicKey(), k.Address().Bytes())
+	}
+
+	for _, invalid := range []string{"", "11abc", "13WRNw4fmssJBvMqMnREwe1eCvUVXfnWXSXGcWXyVvAnQUF3D9S", "0OIl"} {
+		_, err := ParseAddress(invalid)
+		assert.True(t, errors.Is(err, ErrInvalidAddress), invalid)
+		assert.Nil(t, Address(invalid).Bytes())
+	}
+}
+
+func TestAddressJSON(t *testing.T) {
+	var account AccountData
+	assert.NoError(t, json.Unmarshal([]byte(`{"address":"`+testAccount+`"}`), &account))
+	assert.Equal(t, Address(testAccount), account.Address)
+	assert.NoError(t, json.Unmarshal([]byte(`{"address":null}`), &account))
+	assert.Equal(t, Address(""), account.Address)
+
+	// addresses this package can not validate, such as multisig ones, still decode
+	multisig := base58.CheckEncode(1, bytes.Repeat([]byte{0x02}, 34))
+	assert.NoError(t, json.Unmarshal([]byte(`{"address":"`+multisig+`"}`), &account))
+	assert.Equal(t, Address(multisig), account.Address)
+	assert.True(t, errors.Is(account.Address.Validate(), ErrInvalidAddress))
+
+	b, err := json.Marshal(Payments{Payee: testAccount, Amount: 1})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"payee":"`+testAccount+`","amount":1}`, string(b))
+}
+
+func TestAddressValidatedBeforeRequest(t *testing.T) {
+	var requests int32
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	_, err := client.Account().Get(&AccountInput{ID: "13WRNw4fmssJBvMqMnREwe1eCvUVXfnWXSXGcWXyVvAnQUF3D9S"})
+	assert.True(t, errors.Is(err, ErrInvalidAddress))
+	_, err = client.Hotspot().Activity(&HotspotActivityInput{Address: "11abc"})
+	assert.True(t, errors.Is(err, ErrInvalidAddress))
+	_, err = client.Validator().Get("")
+	assert.True(t, errors.Is(err, ErrInvalidAddress))
+	assert.Equal(t, int32(0), requests)
+}
@@ -0,0 +1,402 @@
+package helium
+
+import (
+	"context"
+)
+
+// API aggregates the service interfaces so application code can depend on it instead of a concrete Client,
+// use Client.API to get one backed by a Client and the heliummock package for fakes
+type API interface {
+	Account() AccountAPI
+	Hotspot() HotspotAPI
+	Block() BlockAPI
+	Validator() ValidatorAPI
+	Oracle() OracleAPI
+	City() CityAPI
+	Location() LocationAPI
+	Stat() StatAPI
+	Transaction() TransactionAPI
+	PendingTransaction() PendingTransactionAPI
+	Reward() RewardAPI
+	Vars() VarsAPI
+	Oui() OuiAPI
+	StateChannel() StateChannelAPI
+	DCBurn() DCBurnAPI
+	Challenge() ChallengeAPI
+	Election() ElectionAPI
+}
+
+// AccountAPI is the interface implemented by Account
+type AccountAPI interface {
+	List(input *AccountListInput) (*Accounts, error)
+	ListWithContext(ctx context.Context, input *AccountListInput) (*Accounts, error)
+	ListAll(opts ...PagerOption) *AccountPager
+	Richest(input *AccountRichestInput) (*Accounts, error)
+	RichestWithContext(ctx context.Context, input *AccountRichestInput) (*Accounts, error)
+	Get(input *AccountInput) (*UserAccount, error)
+	GetWithContext(ctx context.Context, input *AccountInput) (*UserAccount, error)
+	Hotspots(input *AccountInput) (*Hotspots, error)
+	HotspotsWithContext(ctx context.Context, input *AccountInput) (*Hotspots, error)
+	HotspotsAll(input *AccountInput, opts ...PagerOption) *HotspotPager
+	Ouis(input *AccountInput) (*Ouis, error)
+	OuisWithContext(ctx context.Context, input *AccountInput) (*Ouis, error)
+	OuisAll(input *AccountInput, opts ...PagerOption) *OuiPager
+	Activity(input *AccountActivityInput) (*Activity, error)
+	ActivityWithContext(ctx context.Context, input *AccountActivityInput) (*Activity, error)
+	ActivityAll(input *AccountActivityInput, opts ...PagerOption) *ActivityPager
+	Roles(input *AccountActivityInput) (*Roles, error)
+	RolesWithContext(ctx context.Context, input *AccountActivityInput) (*Roles, error)
+	RolesAll(input *AccountActivityInput, opts ...PagerOption) *RolePager
+	ActivityCount(input *AccountInput) (*ActivityCount, error)
+	ActivityCountWithContext(ctx context.Context, input *AccountInput) (*ActivityCount, error)
+	Elections(input *AccountInput) (*Elections, error)
+	ElectionsWithContext(ctx context.Context, input *AccountInput) (*Elections, error)
+	ElectionsAll(input *AccountInput, opts ...PagerOption) *ElectionPager
+	Challenges(input *AccountInput) (*Challenges, error)
+	ChallengesWithContext(ctx context.Context, input *AccountInput) (*Challenges, error)
+	ChallengesAll(input *AccountInput, opts ...PagerOption) *ChallengePager
+	PendingTransactions(input *AccountInput) (*PendingTransactions, error)
+	PendingTransactionsWithContext(ctx context.Context, input *AccountInput) (*PendingTransactions, error)
+	PendingTransactionsAll(input *AccountInput, opts ...PagerOption) *PendingTransactionPager
+	Rewards(input *AccountRewardsInput) (*Rewards, error)
+	RewardsWithContext(ctx context.Context, input *AccountRewardsInput) (*Rewards, error)
+	RewardsAll(input *AccountRewardsInput, opts ...PagerOption) *RewardPager
+	RewardSum(input *AccountRewardsInput) (*RewardSum, error)
+	RewardSumWithContext(ctx context.Context, input *AccountRewardsInput) (*RewardSum, error)
+	RewardSumBuckets(input *AccountRewardsInput, bucket Bucket) (*RewardBuckets, error)
+	RewardSumBucketsWithContext(ctx context.Context, input *AccountRewardsInput, bucket Bucket) (*RewardBuckets, error)
+	Stats(input *AccountInput) (*AccountStats, error)
+	StatsWithContext(ctx context.Context, input *AccountInput) (*AccountStats, error)
+}
+
+// HotspotAPI is the interface implemented by Hotspot
+type HotspotAPI interface {
+	List(input *HotspotListInput) (*Hotspots, error)
+	ListWithContext(ctx context.Context, input *HotspotListInput) (*Hotspots, error)
+	ListAll(opts ...PagerOption) *HotspotPager
+	Get(input *HotspotInput) (*HotspotInfo, error)
+	GetWithContext(ctx context.Context, input *HotspotInput) (*HotspotInfo, error)
+	GetByName(input *HotspotInput) (*Hotspots, error)
+	GetByNameWithContext(ctx context.Context, input *HotspotInput) (*Hotspots, error)
+	Search(input *HotspotSearchInput) (*Hotspots, error)
+	SearchWithContext(ctx context.Context, input *HotspotSearchInput) (*Hotspots, error)
+	Distance(input *HotspotDistanceInput) (*Hotspots, error)
+	DistanceWithContext(ctx context.Context, input *HotspotDistanceInput) (*Hotspots, error)
+	DistanceAll(input *HotspotDistanceInput, opts ...PagerOption) *HotspotPager
+	Box(input *HotspotBoxInput) (*Hotspots, error)
+	BoxWithContext(ctx context.Context, input *HotspotBoxInput) (*Hotspots, error)
+	BoxAll(input *HotspotBoxInput, opts ...PagerOption) *HotspotPager
+	GetByHex(input *HotspotHexInput) (*HotspotInfo, error)
+	GetByHexWithContext(ctx context.Context, input *HotspotHexInput) (*HotspotInfo, error)
+	Activity(input *HotspotActivityInput) (*HotspotsActivity, error)
+	ActivityWithContext(ctx context.Context, input *HotspotActivityInput) (*HotspotsActivity, error)
+	ActivityAll(input *HotspotActivityInput, opts ...PagerOption) *HotspotActivityPager
+	Roles(input *HotspotActivityInput) (*Roles, error)
+	RolesWithContext(ctx context.Context, input *HotspotActivityInput) (*Roles, error)
+	RolesAll(input *HotspotActivityInput, opts ...PagerOption) *RolePager
+	ActivityCount(input *HotspotInput) (*HotspotActivityCount, error)
+	ActivityCountWithContext(ctx context.Context, input *HotspotInput) (*HotspotActivityCount, error)
+	Elections(input *HotspotInput) (*Elections, error)
+	ElectionsWithContext(ctx context.Context, input *HotspotInput) (*Elections, error)
+	ElectionsAll(input *HotspotInput, opts ...PagerOption) *ElectionPager
+	CurrentlyElected() (*Elections, error)
+	CurrentlyElectedWithContext(ctx context.Context) (*Elections, error)
+	Challenges(input *HotspotInput) (*Challenges, error)
+	ChallengesWithContext(ctx context.Context, input *HotspotInput) (*Challenges, error)
+	ChallengesAll(input *HotspotInput, opts ...PagerOption) *ChallengePager
+	Rewards(input *HotspotRewardsInput) (*Rewards, error)
+	RewardsWithContext(ctx context.Context, input *HotspotRewardsInput) (*Rewards, error)
+	RewardsAll(input *HotspotRewardsInput, opts ...PagerOption) *RewardPager
+	RewardSum(input *HotspotRewardsInput) (*RewardSum, error)
+	RewardSumWithContext(ctx context.Context, input *HotspotRewardsInput) (*RewardSum, error)
+	RewardSumBuckets(input *HotspotRewardsInput, bucket Bucket) (*RewardBuckets, error)
+	RewardSumBucketsWithContext(ctx context.Context, input *HotspotRewardsInput, bucket Bucket) (*RewardBuckets, error)
+	Witnesses(input *HotspotInput) (*Witnesses, error)
+	WitnessesWithContext(ctx context.Context, input *HotspotInput) (*Witnesses, error)
+	Witnessed(input *HotspotInput) (*Witnesses, error)
+	WitnessedWithContext(ctx context.Context, input *HotspotInput) (*Witnesses, error)
+}
+
+// BlockAPI is the interface implemented by Block
+type BlockAPI interface {
+	List(input *BlockCursorInput) (*Blocks, error)
+	ListWithContext(ctx context.Context, input *BlockCursorInput) (*Blocks, error)
+	ListAll(opts ...PagerOption) *BlockPager
+	Get(input *BlockInput) (*Block, error)
+	GetWithContext(ctx context.Context, input *BlockInput) (*Block, error)
+	CurrentHeight(input *BlockCursorInput) (*Height, error)
+	CurrentHeightWithContext(ctx context.Context, input *BlockCursorInput) (*Height, error)
+	Stats(input *BlockCursorInput) (*BlockStats, error)
+	StatsWithContext(ctx context.Context, input *BlockCursorInput) (*BlockStats, error)
+	GetHeight(input *BlockInput) (*BlockHeight, error)
+	GetHeightWithContext(ctx context.Context, input *BlockInput) (*BlockHeight, error)
+	Transactions(input *BlockInput) (*Transactions, error)
+	TransactionsWithContext(ctx context.Context, input *BlockInput) (*Transactions, error)
+	TransactionsAll(input *BlockInput, opts ...PagerOption) *TransactionPager
+}
+
+// ValidatorAPI is the interface implemented by Validator
+type ValidatorAPI interface {
+	List(input *ValidatorListInput) (*Validators, error)
+	ListWithContext(ctx context.Context, input *ValidatorListInput) (*Validators, error)
+	ListAll(opts ...PagerOption) *ValidatorPager
+	Get(address Address) (*ValidatorInfo, error)
+	GetWithContext(ctx context.Context, address Address) (*ValidatorInfo, error)
+	GetByName(name string) (*ValidatorInfo, error)
+	GetByNameWithContext(ctx context.Context, name string) (*ValidatorInfo, error)
+	Search(term string) (*Validators, error)
+	SearchWithContext(ctx context.Context, term string) (*Validators, error)
+	Activity(input *ValidatorActivityInput) (*ValidatorActivity, error)
+	ActivityWithContext(ctx context.Context, input *ValidatorActivityInput) (*ValidatorActivity, error)
+	ActivityAll(input *ValidatorActivityInput, opts ...PagerOption) *ValidatorActivityPager
+	Roles(input *ValidatorActivityInput) (*Roles, error)
+	RolesWithContext(ctx context.Context, input *ValidatorActivityInput) (*Roles, error)
+	RolesAll(input *ValidatorActivityInput, opts ...PagerOption) *RolePager
+	ActivityCount(address Address, filterTypes []TxnType) (*ValidatorActivityCount, error)
+	ActivityCountWithContext(ctx context.Context, address Address, filterTypes []TxnType) (*ValidatorActivityCount, error)
+	Stats() (*ValidatorStats, error)
+	StatsWithContext(ctx context.Context) (*ValidatorStats, error)
+	ListElected() (*ValidatorElections, error)
+	ListElectedWithContext(ctx context.Context) (*ValidatorElections, error)
+	ElectedAtHeight(height string) (*Validators, error)
+	ElectedAtHeightWithContext(ctx context.Context, height string) (*Validators, error)
+	ElectedAtHash(hash string) (*Validators, error)
+	ElectedAtHashWithContext(ctx context.Context, hash string) (*Validators, error)
+	Rewards(address Address, cursor string, maxTime TimeBound, minTime TimeBound) (*ValidatorRewards, error)
+	RewardsWithContext(ctx context.Context, address Address, cursor string, maxTime TimeBound, minTime TimeBound) (*ValidatorRewards, error)
+	RewardsAll(address Address, maxTime TimeBound, minTime TimeBound, opts ...PagerOption) *ValidatorRewardPager
+	RewardsSum(address Address, maxTime TimeBound, minTime TimeBound) (*ValidatorRewardsSum, error)
+	RewardsSumWithContext(ctx context.Context, address Address, maxTime TimeBound, minTime TimeBound) (*ValidatorRewardsSum, error)
+	RewardsSumBuckets(address Address, maxTime TimeBound, minTime TimeBound, bucket Bucket) (*RewardBuckets, error)
+	RewardsSumBucketsWithContext(ctx context.Context, address Address, maxTime TimeBound, minTime TimeBound, bucket Bucket) (*RewardBuckets, error)
+}
+
+// OracleAPI is the interface implemented by Oracle
+type OracleAPI interface {
+	List(input *OraclePriceListInput) (*OraclePrices, error)
+	ListWithContext(ctx context.Context, input *OraclePriceListInput) (*OraclePrices, error)
+	ListAll(opts ...PagerOption) *OraclePricePager
+	Current() (*OraclePrice, error)
+	CurrentWithContext(ctx context.Context) (*OraclePrice, error)
+	Stats(input *OraclePriceStatsInput) (*OraclePriceStats, error)
+	StatsWithContext(ctx context.Context, input *OraclePriceStatsInput) (*OraclePriceStats, error)
+	Block(input *OraclePriceBlockInput) (*OraclePrice, error)
+	BlockWithContext(ctx context.Context, input *OraclePriceBlockInput) (*OraclePrice, error)
+	Activity(input *OraclePriceActivityInput) (*OraclePriceActivity, error)
+	ActivityWithContext(ctx context.Context, input *OraclePriceActivityInput) (*OraclePriceActivity, error)
+	ActivityAll(opts ...PagerOption) *OraclePriceActivityPager
+}
+
+// CityAPI is the interface implemented by City
+type CityAPI interface {
+	Search(input *CitySearchInput) (*Cities, error)
+	SearchWithContext(ctx context.Context, input *CitySearchInput) (*Cities, error)
+	SearchAll(input *CitySearchInput, opts ...PagerOption) *CityPager
+	Hotspots(input *CityInput) (*Hotspots, error)
+	HotspotsWithContext(ctx context.Context, input *CityInput) (*Hotspots, error)
+	HotspotsAll(input *CityInput, opts ...PagerOption) *HotspotPager
+}
+
+// LocationAPI is the interface implemented by Location
+type LocationAPI interface {
+	Get(input *LocationInput) (*LocationInfo, error)
+	GetWithContext(ctx context.Context, input *LocationInput) (*LocationInfo, error)
+}
+
+// StatAPI is the interface implemented by Stat
+type StatAPI interface {
+	List() (*Stats, error)
+	ListWithContext(ctx context.Context) (*Stats, error)
+	TokenSupply() (*TokenSupply, error)
+	TokenSupplyWithContext(ctx context.Context) (*TokenSupply, error)
+}
+
+// TransactionAPI is the interface implemented by Transaction
+type TransactionAPI interface {
+	Get(hash string) (*TransactionInfo, error)
+	GetWithContext(ctx context.Context, hash string) (*TransactionInfo, error)
+}
+
+// PendingTransactionAPI is the interface implemented by PendingTransaction
+type PendingTransactionAPI interface {
+	Get(input *PendingTransactionInput) (*PendingTransactions, error)
+	GetWithContext(ctx context.Context, input *PendingTransactionInput) (*PendingTransactions, error)
+	Submit(input *TransactionSubmitInput) error
+	SubmitWithContext(ctx context.Context, input *TransactionSubmitInput) error
+}
+
+// RewardAPI is the interface implemented by Reward
+type RewardAPI interface {
+	List(input *RewardListInput) (*RewardTransactions, error)
+	ListWithContext(ctx context.Context, input *RewardListInput) (*RewardTransactions, error)
+	ListAll(input *RewardListInput, opts ...PagerOption) *RewardTransactionPager
+	Sum(input *RewardSumInput) (*RewardStats, error)
+	SumWithContext(ctx context.Context, input *RewardSumInput) (*RewardStats, error)
+	SumBuckets(input *RewardSumInput, bucket Bucket) (*RewardBuckets, error)
+	SumBucketsWithContext(ctx context.Context, input *RewardSumInput, bucket Bucket) (*RewardBuckets, error)
+}
+
+// VarsAPI is the interface implemented by Vars
+type VarsAPI interface {
+	List() (*ChainVars, error)
+	ListWithContext(ctx context.Context) (*ChainVars, error)
+	Get(name string) (*ChainVar, error)
+	GetWithContext(ctx context.Context, name string) (*ChainVar, error)
+	Activity(input *VarActivityInput) (*VarActivity, error)
+	ActivityWithContext(ctx context.Context, input *VarActivityInput) (*VarActivity, error)
+	ActivityAll(opts ...PagerOption) *VarActivityPager
+}
+
+// OuiAPI is the interface implemented by Oui
+type OuiAPI interface {
+	List(input *OuiListInput) (*Ouis, error)
+	ListWithContext(ctx context.Context, input *OuiListInput) (*Ouis, error)
+	ListAll(opts ...PagerOption) *OuiPager
+	Get(oui int) (*OuiInfo, error)
+	GetWithContext(ctx context.Context, oui int) (*OuiInfo, error)
+	Last() (*OuiInfo, error)
+	LastWithContext(ctx context.Context) (*OuiInfo, error)
+	Stats() (*OuiStats, error)
+	StatsWithContext(ctx context.Context) (*OuiStats, error)
+}
+
+// StateChannelAPI is the interface implemented by StateChannel
+type StateChannelAPI interface {
+	List(input *StateChannelListInput) (*StateChannels, error)
+	ListWithContext(ctx context.Context, input *StateChannelListInput) (*StateChannels, error)
+	ListAll(opts ...PagerOption) *StateChannelPager
+	Stats() (*StateChannelStats, error)
+	StatsWithContext(ctx context.Context) (*StateChannelStats, error)
+	HotspotTraffic(input *HotspotTrafficInput) (*HotspotTraffic, error)
+	HotspotTrafficWithContext(ctx context.Context, input *HotspotTrafficInput) (*HotspotTraffic, error)
+}
+
+// DCBurnAPI is the interface implemented by DCBurn
+type DCBurnAPI interface {
+	List(input *DCBurnListInput) (*DCBurns, error)
+	ListWithContext(ctx context.Context, input *DCBurnListInput) (*DCBurns, error)
+	ListAll(input *DCBurnListInput, opts ...PagerOption) *DCBurnPager
+	Sum(input *DCBurnSumInput) (*DCBurnSum, error)
+	SumWithContext(ctx context.Context, input *DCBurnSumInput) (*DCBurnSum, error)
+	SumBuckets(input *DCBurnSumInput, bucket Bucket) (*DCBurnBuckets, error)
+	SumBucketsWithContext(ctx context.Context, input *DCBurnSumInput, bucket Bucket) (*DCBurnBuckets, error)
+	Stats() (*DCBurnStats, error)
+	StatsWithContext(ctx context.Context) (*DCBurnStats, error)
+	Value(burns []DCBurnData) (*DCBurnValue, error)
+	ValueWithContext(ctx context.Context, burns []DCBurnData) (*DCBurnValue, error)
+}
+
+// ChallengeAPI is the interface implemented by Challenge
+type ChallengeAPI interface {
+	List(input *ChallengeListInput) (*Challenges, error)
+	ListWithContext(ctx context.Context, input *ChallengeListInput) (*Challenges, error)
+	ListAll(input *ChallengeListInput, opts ...PagerOption) *ChallengePager
+	Stats() (*ChallengeStats, error)
+	StatsWithContext(ctx context.Context) (*ChallengeStats, error)
+}
+
+// ElectionAPI is the interface implemented by Election
+type ElectionAPI interface {
+	List(input *ElectionListInput) (*Elections, error)
+	ListWithContext(ctx context.Context, input *ElectionListInput) (*Elections, error)
+	ListAll(input *ElectionListInput, opts ...PagerOption) *ElectionPager
+}
+
+var (
+	_ AccountAPI            = (*Account)(nil)
+	_ HotspotAPI            = (*Hotspot)(nil)
+	_ BlockAPI              = (*Block)(nil)
+	_ ValidatorAPI          = (*Validator)(nil)
+	_ OracleAPI             = (*Oracle)(nil)
+	_ CityAPI               = (*City)(nil)
+	_ LocationAPI           = (*Location)(nil)
+	_ StatAPI               = (*Stat)(nil)
+	_ TransactionAPI        = (*Transaction)(nil)
+	_ PendingTransactionAPI = (*PendingTransaction)(nil)
+	_ RewardAPI             = (*Reward)(nil)
+	_ VarsAPI               = (*Vars)(nil)
+	_ OuiAPI                = (*Oui)(nil)
+	_ StateChannelAPI       = (*StateChannel)(nil)
+	_ DCBurnAPI             = (*DCBurn)(nil)
+	_ ChallengeAPI          = (*Challenge)(nil)
+	_ ElectionAPI           = (*Election)(nil)
+	_ API                   = clientAPI{}
+)
+
+// clientAPI implements API with a Client
+type clientAPI struct {
+	c *Client
+}
+
+// API returns the client's services as an API
+func (c *Client) API() API {
+	return clientAPI{c}
+}
+
+func (a clientAPI) Account() AccountAPI {
+	return a.c.Account()
+}
+
+func (a clientAPI) Hotspot() HotspotAPI {
+	return a.c.Hotspot()
+}
+
+func (a clientAPI) Block() BlockAPI {
+	return a.c.Block()
+}
+
+func (a clientAPI) Validator() ValidatorAPI {
+	return a.c.Validator()
+}
+
+func (a clientAPI) Oracle() OracleAPI {
+	return a.c.Oracle()
+}
+
+func (a clientAPI) City() CityAPI {
+	return a.c.City()
+}
+
+func (a clientAPI) Location() LocationAPI {
+	return a.c.Location()
+}
+
+func (a clientAPI) Stat() StatAPI {
+	return a.c.Stat()
+}
+
+func (a clientAPI) Transaction() TransactionAPI {
+	return a.c.Transaction()
+}
+
+func (a clientAPI) PendingTransaction() PendingTransactionAPI {
+	return a.c.PendingTransaction()
+}
+
+func (a clientAPI) Reward() RewardAPI {
+	return a.c.Reward()
+}
+
+func (a clientAPI) Vars() VarsAPI {
+	return a.c.Vars()
+}
+
+func (a clientAPI) Oui() OuiAPI {
+	return a.c.Oui()
+}
+
+func (a clientAPI) StateChannel() StateChannelAPI {
+	return a.c.StateChannel()
+}
+
+func (a clientAPI) DCBurn() DCBurnAPI {
+	return a.c.DCBurn()
+}
+
+func (a clientAPI) Challenge() ChallengeAPI {
+	return a.c.Challenge()
+}
+
+func (a clientAPI) Election() ElectionAPI {
+	return a.c.Election()
+}
@@ -2,6 +2,7 @@ package helium
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -42,15 +43,15 @@ type BlockStatsData struct {
 
 type Blocks struct {
 	Data   []BlockData `json:"data"`
-	Cursor string `json:"cursor"`
+	Cursor string      `json:"cursor"`
 }
 type BlockData struct {
-	TransactionCount int    `json:"transaction_count"`
-	Time             int    `json:"time"`
-	SnapshotHash     string `json:"snapshot_hash"`
-	PrevHash         string `json:"prev_hash"`
-	Height           int    `json:"height"`
-	Hash             string `json:"hash"`
+	TransactionCount int       `json:"transaction_count"`
+	Time             Timestamp `json:"time"`
+	SnapshotHash     string    `json:"snapshot_hash"`
+	PrevHash         string    `json:"prev_hash"`
+	Height           int       `json:"height"`
+	Hash             string    `json:"hash"`
 }
 
 type BlockHeight struct {
@@ -58,37 +59,16 @@ type BlockHeight struct {
 }
 
 type Transactions struct {
-	Data []TransactionData `json:"data"`
+	Data   Txns   `json:"data"`
+	Cursor string `json:"cursor"`
 }
 
 type Hash struct {
 	Data BlockData `json:"data"`
 }
 
-type TransactionData struct {
-	Version         int     `json:"version,omitempty"`
-	Type            string  `json:"type"`
-	Time            int     `json:"time"`
-	Signature       string  `json:"signature"`
-	SecretHash      string  `json:"secret_hash,omitempty"`
-	Owner           string  `json:"owner,omitempty"`
-	OnionKeyHash    string  `json:"onion_key_hash"`
-	Location        string  `json:"location,omitempty"`
-	Lng             float64 `json:"lng,omitempty"`
-	Lat             float64 `json:"lat,omitempty"`
-	Height          int     `json:"height"`
-	Hash            string  `json:"hash"`
-	Fee             int     `json:"fee"`
-	Challenger      string  `json:"challenger"`
-	BlockHash       string  `json:"block_hash,omitempty"`
-	Secret          string  `json:"secret,omitempty"`
-	Path            []Path  `json:"path,omitempty"`
-	ChallengerOwner string  `json:"challenger_owner,omitempty"`
-	ChallengerLoc   string  `json:"challenger_loc,omitempty"`
-}
-
 type HashTransactions struct {
-	Data []TransactionData `json:"data"`
+	Data Txns `json:"data"`
 }
 
 type BlockCursorInput struct {
@@ -96,14 +76,20 @@ type BlockCursorInput struct {
 }
 
 type BlockInput struct {
-	ID string
+	ID     string
+	Cursor string
 }
 
 // List Retrieves block descriptions.
 func (b *Block) List(input *BlockCursorInput) (*Blocks, error) {
+	return b.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (b *Block) ListWithContext(ctx context.Context, input *BlockCursorInput) (*Blocks, error) {
 	params := make(map[string]string)
 	params["cursor"] = input.Cursor
-	resp, err := b.c.Request(http.MethodGet, "/blocks", new(bytes.Buffer), params)
+	resp, err := b.c.RequestContext(ctx, http.MethodGet, "/blocks", new(bytes.Buffer), params)
 	if err != nil {
 		return &Blocks{}, err
 	}
@@ -117,9 +103,21 @@ func (b *Block) List(input *BlockCursorInput) (*Blocks, error) {
 	return blocks, nil
 }
 
+// ListAll iterates over every block, newest first.
+func (b *Block) ListAll(opts ...PagerOption) *BlockPager {
+	return NewBlockPager(func(ctx context.Context, cursor string) (*Blocks, error) {
+		return b.ListWithContext(ctx, &BlockCursorInput{Cursor: cursor})
+	}, opts...)
+}
+
 // Get Get block descriptor for block at height
 func (b *Block) Get(input *BlockInput) (*Block, error) {
-	resp, err := b.c.Request(http.MethodGet, fmt.Sprintf("/blocks/%s", input.ID), new(bytes.Buffer), nil)
+	return b.GetWithContext(context.Background(), input)
+}
+
+// GetWithContext is Get with a caller supplied context.
+func (b *Block) GetWithContext(ctx context.Context, input *BlockInput) (*Block, error) {
+	resp, err := b.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/blocks/%s", input.ID), new(bytes.Buffer), nil)
 	if err != nil {
 		return &Block{}, err
 	}
@@ -135,9 +133,14 @@ func (b *Block) Get(input *BlockInput) (*Block, error) {
 
 // CurrentHeight Gets the current height of the blockchainn.
 func (b *Block) CurrentHeight(input *BlockCursorInput) (*Height, error) {
+	return b.CurrentHeightWithContext(context.Background(), input)
+}
+
+// CurrentHeightWithContext is CurrentHeight with a caller supplied context.
+func (b *Block) CurrentHeightWithContext(ctx context.Context, input *BlockCursorInput) (*Height, error) {
 	params := make(map[string]string)
 	params["cursor"] = input.Cursor
-	resp, err := b.c.Request(http.MethodGet, "/blocks/height", new(bytes.Buffer), params)
+	resp, err := b.c.RequestContext(ctx, http.MethodGet, "/blocks/height", new(bytes.Buffer), params)
 	if err != nil {
 		return &Height{}, err
 	}
@@ -153,9 +156,14 @@ func (b *Block) CurrentHeight(input *BlockCursorInput) (*Height, error) {
 
 // Stats Get statistics on block production times.
 func (b *Block) Stats(input *BlockCursorInput) (*BlockStats, error) {
+	return b.StatsWithContext(context.Background(), input)
+}
+
+// StatsWithContext is Stats with a caller supplied context.
+func (b *Block) StatsWithContext(ctx context.Context, input *BlockCursorInput) (*BlockStats, error) {
 	params := make(map[string]string)
 	params["cursor"] = input.Cursor
-	resp, err := b.c.Request(http.MethodGet, "/blocks/stats", new(bytes.Buffer), params)
+	resp, err := b.c.RequestContext(ctx, http.MethodGet, "/blocks/stats", new(bytes.Buffer), params)
 	if err != nil {
 		return &BlockStats{}, err
 	}
@@ -171,7 +179,12 @@ func (b *Block) Stats(input *BlockCursorInput) (*BlockStats, error) {
 
 // GetHeight Get block descriptor for block at height
 func (b *Block) GetHeight(input *BlockInput) (*BlockHeight, error) {
-	resp, err := b.c.Request(http.MethodGet, fmt.Sprintf("/blocks/%s/height", input.ID), new(bytes.Buffer), nil)
+	return b.GetHeightWithContext(context.Background(), input)
+}
+
+// GetHeightWithContext is GetHeight with a caller supplied context.
+func (b *Block) GetHeightWithContext(ctx context.Context, input *BlockInput) (*BlockHeight, error) {
+	resp, err := b.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/blocks/%s/height", input.ID), new(bytes.Buffer), nil)
 	if err != nil {
 		return &BlockHeight{}, err
 	}
@@ -187,7 +200,16 @@ func (b *Block) GetHeight(input *BlockInput) (*BlockHeight, error) {
 
 // Transactions Get transactions for a block at a given height.
 func (b *Block) Transactions(input *BlockInput) (*Transactions, error) {
-	resp, err := b.c.Request(http.MethodGet, fmt.Sprintf("/blocks/%s/transactions", input.ID), new(bytes.Buffer), nil)
+	return b.TransactionsWithContext(context.Background(), input)
+}
+
+// TransactionsWithContext is Transactions with a caller supplied context.
+func (b *Block) TransactionsWithContext(ctx context.Context, input *BlockInput) (*Transactions, error) {
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := b.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/blocks/%s/transactions", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &Transactions{}, err
 	}
@@ -199,4 +221,13 @@ func (b *Block) Transactions(input *BlockInput) (*Transactions, error) {
 		return &Transactions{}, err
 	}
 	return transactions, nil
-}
\ No newline at end of file
+}
+
+// TransactionsAll iterates over every transaction in a block.
+func (b *Block) TransactionsAll(input *BlockInput, opts ...PagerOption) *TransactionPager {
+	return NewTransactionPager(func(ctx context.Context, cursor string) (*Transactions, error) {
+		page := *input
+		page.Cursor = cursor
+		return b.TransactionsWithContext(ctx, &page)
+	}, opts...)
+}
@@ -0,0 +1,82 @@
+package helium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Challenge handles the proof of coverage challenges of the whole network at /challenges
+type Challenge struct {
+	c *Client
+}
+
+// Challenge returns the Challenge client
+func (c *Client) Challenge() *Challenge {
+	return &Challenge{c}
+}
+
+type ChallengeStats struct {
+	Data ChallengeCounts `json:"data"`
+}
+
+type ChallengeListInput struct {
+	MinTime TimeBound
+	MaxTime TimeBound
+	Cursor  string
+}
+
+// List Lists the proof of coverage receipts of the whole network in a given timeframe, newest first.
+func (c *Challenge) List(input *ChallengeListInput) (*Challenges, error) {
+	return c.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (c *Challenge) ListWithContext(ctx context.Context, input *ChallengeListInput) (*Challenges, error) {
+	params := make(map[string]string)
+	params["cursor"] = input.Cursor
+	timeParams(params, input.MinTime, input.MaxTime)
+	resp, err := c.c.RequestContext(ctx, http.MethodGet, "/challenges", new(bytes.Buffer), params)
+	if err != nil {
+		return &Challenges{}, err
+	}
+	defer resp.Body.Close()
+
+	var challenges *Challenges
+	err = json.NewDecoder(resp.Body).Decode(&challenges)
+	if err != nil {
+		return &Challenges{}, err
+	}
+	return challenges, nil
+}
+
+// ListAll iterates over the proof of coverage receipts of the whole network in a given timeframe.
+func (c *Challenge) ListAll(input *ChallengeListInput, opts ...PagerOption) *ChallengePager {
+	return NewChallengePager(func(ctx context.Context, cursor string) (*Challenges, error) {
+		page := *input
+		page.Cursor = cursor
+		return c.ListWithContext(ctx, &page)
+	}, opts...)
+}
+
+// Stats Returns the number of active challenges and of challenges completed in the last day.
+func (c *Challenge) Stats() (*ChallengeStats, error) {
+	return c.StatsWithContext(context.Background())
+}
+
+// StatsWithContext is Stats with a caller supplied context.
+func (c *Challenge) StatsWithContext(ctx context.Context) (*ChallengeStats, error) {
+	resp, err := c.c.RequestContext(ctx, http.MethodGet, "/challenges/stats", new(bytes.Buffer), nil)
+	if err != nil {
+		return &ChallengeStats{}, err
+	}
+	defer resp.Body.Close()
+
+	var challengeStats *ChallengeStats
+	err = json.NewDecoder(resp.Body).Decode(&challengeStats)
+	if err != nil {
+		return &ChallengeStats{}, err
+	}
+	return challengeStats, nil
+}
@@ -0,0 +1,39 @@
+package helium
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChallengeList(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/challenges", r.URL.Path)
+		assert.Equal(t, "-1 hour", r.URL.Query().Get("min_time"))
+		assert.Equal(t, "c1", r.URL.Query().Get("cursor"))
+		w.Write([]byte(`{"data":[{"type":"poc_receipts_v1","hash":"p1","height":10,"time":1622505600,"challenger":"` + testAddress + `",` +
+			`"path":[{"challengee":"` + testAddress + `","witnesses":[{"gateway":"` + testAddress + `","timestamp":1622505590123456789,"is_valid":true}]}]}]}`))
+	}))
+
+	challenges, err := client.Challenge().List(&ChallengeListInput{MinTime: Ago(time.Hour), Cursor: "c1"})
+	assert.NoError(t, err)
+	witness := challenges.Data[0].Path[0].Witnesses[0]
+	assert.True(t, witness.IsValid)
+	assert.Equal(t, 123456789, witness.Timestamp.Nanosecond())
+	assert.True(t, witness.Timestamp.Before(challenges.Data[0].Time.Time))
+}
+
+func TestElectionList(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/elections", r.URL.Path)
+		assert.Equal(t, "2021-06-01T00:00:00Z", r.URL.Query().Get("max_time"))
+		w.Write([]byte(`{"data":[{"type":"consensus_group_v1","hash":"e1","height":10,"time":1622505600,"delay":3,"members":["` + testAddress + `"]}]}`))
+	}))
+
+	elections, err := client.Election().List(&ElectionListInput{MaxTime: At(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC))})
+	assert.NoError(t, err)
+	assert.Equal(t, []Address{testAddress}, elections.Data[0].Members)
+	assert.Equal(t, 3, elections.Data[0].Delay)
+}
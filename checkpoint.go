@@ -0,0 +1,179 @@
+package helium
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// ErrCheckpointMismatch is returned by a pager resumed from a checkpoint taken on a different endpoint or parameters
+var ErrCheckpointMismatch = errors.New("helium: checkpoint does not match pager")
+
+// Checkpoint records how far a pager has got so a long crawl can be resumed with ResumeFrom.
+// It is taken between items, so every item returned by Next before the checkpoint was taken is
+// not returned again and no item after it is skipped.
+type Checkpoint struct {
+	// Endpoint is the path of the paginated endpoint, e.g. /accounts/:address/activity
+	Endpoint string `json:"endpoint"`
+	// Params are the query parameters of the endpoint other than the cursor
+	Params map[string]string `json:"params,omitempty"`
+	// Cursor fetches the page to resume from, it is empty for the first page
+	Cursor string `json:"cursor"`
+	// Offset is the number of items of the Cursor page already returned
+	Offset int `json:"offset"`
+	// Items is the number of items returned before the checkpoint
+	Items int `json:"items"`
+	// Pages is the number of pages fetched before the checkpoint
+	Pages int `json:"pages"`
+	// Done is set once the last item has been returned
+	Done bool `json:"done"`
+}
+
+// ResumeFrom resumes a pager from a checkpoint. The first page fetched is checked against the
+// checkpoint's endpoint and params, and the pager stops with ErrCheckpointMismatch if they differ.
+func ResumeFrom(cp *Checkpoint) PagerOption {
+	return func(p *Pager) {
+		p.resume = cp
+		p.cursor = cp.Cursor
+		p.skip = cp.Offset
+		p.items = cp.Items
+		p.pages = cp.Pages
+		if cp.Offset > 0 && p.pages > 0 {
+			// the partially read page is fetched again
+			p.pages--
+		}
+		p.started = cp.Done
+	}
+}
+
+// Checkpoint returns the pager's position between the last item returned by Next and the next one
+func (p *Pager) Checkpoint() *Checkpoint {
+	cp := &Checkpoint{
+		Endpoint: p.endpoint,
+		Params:   p.params,
+		Items:    p.items,
+		Pages:    p.pages,
+	}
+	if p.resume != nil {
+		// nothing has been fetched since resuming
+		cp.Endpoint = p.resume.Endpoint
+		cp.Params = p.resume.Params
+		cp.Cursor = p.resume.Cursor
+		cp.Offset = p.resume.Offset
+		cp.Pages = p.resume.Pages
+		cp.Done = p.resume.Done
+		return cp
+	}
+	switch {
+	case !p.started:
+		cp.Cursor = p.cursor
+	case p.index+1 >= p.size:
+		cp.Cursor = p.cursor
+		cp.Done = len(p.cursor) == 0
+	default:
+		cp.Cursor = p.pageCursor
+		cp.Offset = p.index + 1
+	}
+	return cp
+}
+
+// WriteCheckpoint writes cp as JSON to w
+func WriteCheckpoint(w io.Writer, cp *Checkpoint) error {
+	return json.NewEncoder(w).Encode(cp)
+}
+
+// ReadCheckpoint reads a checkpoint written by WriteCheckpoint
+func ReadCheckpoint(r io.Reader) (*Checkpoint, error) {
+	var cp *Checkpoint
+	err := json.NewDecoder(r).Decode(&cp)
+	if err != nil {
+		return nil, err
+	}
+	if cp == nil {
+		return nil, fmt.Errorf("helium: empty checkpoint")
+	}
+	return cp, nil
+}
+
+// SaveCheckpoint writes cp to the file at path, replacing it atomically so a crash never leaves a partial checkpoint
+func SaveCheckpoint(path string, cp *Checkpoint) error {
+	return saveFile(path, func(w io.Writer) error {
+		return WriteCheckpoint(w, cp)
+	})
+}
+
+// saveFile replaces the file at path with what write writes, through a temporary file readable only by
+// its owner that is renamed over path once it is complete
+func saveFile(path string, write func(io.Writer) error) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	err = write(tmp)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadCheckpoint reads a checkpoint saved by SaveCheckpoint
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadCheckpoint(f)
+}
+
+// matches reports whether the checkpoint was taken on endpoint with params
+func (cp *Checkpoint) matches(endpoint string, params map[string]string) bool {
+	if cp.Endpoint != endpoint || len(cp.Params) != len(params) {
+		return false
+	}
+	for key, value := range cp.Params {
+		if other, ok := params[key]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
+
+type pageRequestKey struct{}
+
+// pageRequest records the endpoint and params of a page request made by a pager
+type pageRequest struct {
+	endpoint string
+	params   map[string]string
+}
+
+// recordPageRequest notes the endpoint and params of a request made on behalf of a pager
+func recordPageRequest(ctx context.Context, path string, params map[string]string) {
+	page, ok := ctx.Value(pageRequestKey{}).(*pageRequest)
+	if !ok {
+		return
+	}
+	page.endpoint = path
+	page.params = nil
+	for key, value := range params {
+		if key == "cursor" {
+			continue
+		}
+		if page.params == nil {
+			page.params = make(map[string]string)
+		}
+		page.params[key] = value
+	}
+}
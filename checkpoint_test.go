@@ -0,0 +1,66 @@
+package helium
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpointResume(t *testing.T) {
+	var requests int32
+	client := testClient(t, pagedHotspots(7, 3, &requests))
+	all := []string{"h0", "h1", "h2", "h3", "h4", "h5", "h6"}
+
+	for stop := 0; stop <= len(all); stop++ {
+		var seen []string
+		pager := client.Hotspot().ListAll()
+		for len(seen) < stop && pager.Next(context.Background()) {
+			seen = append(seen, pager.Item().Name)
+		}
+
+		var buf bytes.Buffer
+		assert.NoError(t, WriteCheckpoint(&buf, pager.Checkpoint()))
+		cp, err := ReadCheckpoint(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, stop, cp.Items)
+
+		resumed := client.Hotspot().ListAll(ResumeFrom(cp))
+		for resumed.Next(context.Background()) {
+			seen = append(seen, resumed.Item().Name)
+		}
+		assert.NoError(t, resumed.Err())
+		assert.Equal(t, all, seen, "stopped after %d items", stop)
+		assert.Equal(t, len(all), resumed.Items())
+	}
+}
+
+func TestCheckpointFile(t *testing.T) {
+	var requests int32
+	client := testClient(t, pagedHotspots(7, 3, &requests))
+
+	pager := client.Account().ActivityAll(&AccountActivityInput{ID: testAddress}, MaxItems(1))
+	for pager.Next(context.Background()) {
+	}
+	path := filepath.Join(t.TempDir(), "crawl.json")
+	assert.NoError(t, SaveCheckpoint(path, pager.Checkpoint()))
+
+	cp, err := LoadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "/accounts/"+testAddress+"/activity", cp.Endpoint)
+
+	// a checkpoint is only valid for the endpoint it was taken on
+	hotspots := client.Hotspot().ListAll(ResumeFrom(cp))
+	assert.False(t, hotspots.Next(context.Background()))
+	assert.True(t, errors.Is(hotspots.Err(), ErrCheckpointMismatch))
+}
+
+func TestCheckpointParams(t *testing.T) {
+	cp := &Checkpoint{Endpoint: "/hotspots/location/distance", Params: map[string]string{"lat": "1", "lon": "2"}}
+	assert.True(t, cp.matches("/hotspots/location/distance", map[string]string{"lat": "1", "lon": "2"}))
+	assert.False(t, cp.matches("/hotspots/location/distance", map[string]string{"lat": "1", "lon": "3"}))
+	assert.False(t, cp.matches("/hotspots", map[string]string{"lat": "1", "lon": "2"}))
+}
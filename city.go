@@ -2,9 +2,10 @@ package helium
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
-	"encoding/json"
 )
 
 // City handles api endpoint /cities docs located at https://docs.helium.com/api/blockchain/cities
@@ -18,7 +19,8 @@ func (c *Client) City() *City {
 }
 
 type Cities struct {
-	Data []CityData`json:"data"`
+	Data   []CityData `json:"data"`
+	Cursor string     `json:"cursor"`
 }
 
 type CityData struct {
@@ -33,21 +35,31 @@ type CityData struct {
 }
 
 type CitySearchInput struct {
-	Term string
+	Term   string
+	Cursor string
 }
 
 type CityInput struct {
-	ID string
+	ID     string
+	Cursor string
 }
 
-// Search List all known hotspot cities with the total hotspot count for each city. 
+// Search List all known hotspot cities with the total hotspot count for each city.
 func (c *City) Search(input *CitySearchInput) (*Cities, error) {
+	return c.SearchWithContext(context.Background(), input)
+}
+
+// SearchWithContext is Search with a caller supplied context.
+func (c *City) SearchWithContext(ctx context.Context, input *CitySearchInput) (*Cities, error) {
 	if len(input.Term) < 1 {
 		return &Cities{}, fmt.Errorf("search term must be 1 character or more, 3 is recommended")
 	}
 	params := make(map[string]string)
 	params["search"] = input.Term
-	resp, err := c.c.Request(http.MethodGet, "/cities", new(bytes.Buffer), params)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := c.c.RequestContext(ctx, http.MethodGet, "/cities", new(bytes.Buffer), params)
 	if err != nil {
 		return &Cities{}, err
 	}
@@ -61,9 +73,27 @@ func (c *City) Search(input *CitySearchInput) (*Cities, error) {
 	return cities, nil
 }
 
+// SearchAll iterates over every city matching the search term.
+func (c *City) SearchAll(input *CitySearchInput, opts ...PagerOption) *CityPager {
+	return NewCityPager(func(ctx context.Context, cursor string) (*Cities, error) {
+		page := *input
+		page.Cursor = cursor
+		return c.SearchWithContext(ctx, &page)
+	}, opts...)
+}
+
 // Hotspots Lists all known hotspots for a given city_id.
 func (c *City) Hotspots(input *CityInput) (*Hotspots, error) {
-	resp, err := c.c.Request(http.MethodGet, fmt.Sprintf("/cities/%s/hotspots", input.ID), new(bytes.Buffer), nil)
+	return c.HotspotsWithContext(context.Background(), input)
+}
+
+// HotspotsWithContext is Hotspots with a caller supplied context.
+func (c *City) HotspotsWithContext(ctx context.Context, input *CityInput) (*Hotspots, error) {
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := c.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/cities/%s/hotspots", input.ID), new(bytes.Buffer), params)
 	if err != nil {
 		return &Hotspots{}, err
 	}
@@ -75,4 +105,13 @@ func (c *City) Hotspots(input *CityInput) (*Hotspots, error) {
 		return &Hotspots{}, err
 	}
 	return hotspots, nil
-}
\ No newline at end of file
+}
+
+// HotspotsAll iterates over every hotspot for a given city_id.
+func (c *City) HotspotsAll(input *CityInput, opts ...PagerOption) *HotspotPager {
+	return NewHotspotPager(func(ctx context.Context, cursor string) (*Hotspots, error) {
+		page := *input
+		page.Cursor = cursor
+		return c.HotspotsWithContext(ctx, &page)
+	}, opts...)
+}
@@ -2,8 +2,11 @@ package helium
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -21,6 +24,10 @@ type Client struct {
 	client *http.Client
 	URL    string
 	Key    string
+	retry  *RetryPolicy
+
+	limiter          *rateLimiter
+	endpointLimiters []*endpointLimiter
 }
 
 // Option is a configuration option
@@ -32,7 +39,7 @@ func DefaultClient() *Client {
 	}
 	return &Client{
 		client: client,
-		URL: APIURL,
+		URL:    APIURL,
 	}
 }
 
@@ -57,18 +64,93 @@ func WithKey(key string) Option {
 	}
 }
 
-// WithURL for supplying a non default api endpoint like the Beta
+// WithURL for supplying a non default api endpoint like the Beta. The url may include a scheme and a path prefix,
+// e.g. http://localhost:8080/v1, https is used when the scheme is omitted.
 func WithURL(url string) Option {
 	return func(c *Client) {
 		c.URL = url
 	}
 }
 
+// WithHTTPClient client that makes requests with a custom http.Client, e.g. one configured for a proxy
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.client = client
+	}
+}
+
+// WithTransport client that makes requests through a custom http.RoundTripper
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		// copy so a client supplied by WithHTTPClient is not modified
+		client := *c.client
+		client.Transport = transport
+		c.client = &client
+	}
+}
+
+// baseURL parses URL, defaulting to https when no scheme is given
+func (c *Client) baseURL() (*url.URL, error) {
+	rawURL := c.URL
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	base, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if len(base.Host) == 0 {
+		return nil, fmt.Errorf("helium: invalid api url %q", c.URL)
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/")
+	base.RawPath = ""
+	return base, nil
+}
+
 // Request handles http requests
 func (c *Client) Request(method string, path string, body *bytes.Buffer, params map[string]string) (*http.Response, error) {
-	path = fmt.Sprintf("https://%s%s", c.URL, path)
+	return c.RequestContext(context.Background(), method, path, body, params)
+}
+
+// RequestContext handles http requests, cancelling the request when ctx is done
+func (c *Client) RequestContext(ctx context.Context, method string, path string, body *bytes.Buffer, params map[string]string) (*http.Response, error) {
+	recordPageRequest(ctx, path, params)
+
+	var payload []byte
+	if body != nil {
+		payload = body.Bytes()
+	}
+	if c.retry == nil || !c.retry.retries(method) {
+		return c.do(ctx, method, path, payload, params)
+	}
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		resp, err := c.do(ctx, method, path, payload, params)
+		if err == nil {
+			return resp, nil
+		}
+		wait, ok := c.retry.backoff(attempt, start, err)
+		if !ok {
+			return nil, err
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// do makes a single attempt at a request
+func (c *Client) do(ctx context.Context, method string, path string, payload []byte, params map[string]string) (*http.Response, error) {
+	if err := c.wait(ctx, path); err != nil {
+		return nil, err
+	}
+	base, err := c.baseURL()
+	if err != nil {
+		return nil, err
+	}
 	// Create request
-	req, err := http.NewRequest(method, path, body)
+	req, err := http.NewRequestWithContext(ctx, method, base.String()+path, bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -91,9 +173,10 @@ func (c *Client) Request(method string, path string, body *bytes.Buffer, params
 		return nil, err
 	}
 
-	if resp.Status != "200 OK" {
-		return nil, fmt.Errorf("request returned %s\n, %s", resp.Status, err.Error())
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		return nil, newAPIError(method, path, resp)
 	}
 
 	return resp, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,112 @@
+package helium
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testAddress is a valid hotspot address for requests that don't reach a real api
+const testAddress = "112qB3YaH5bZkCnKA5uRH7tBtGNv2Y5B4smv1jsmvGUzgKT71QpE"
+
+func testClient(t *testing.T, handler http.Handler, opts ...Option) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return ClientWithOptions(append([]Option{WithURL(server.URL)}, opts...)...)
+}
+
+func TestRequestContextCancel(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.Hotspot().GetWithContext(ctx, &HotspotInput{Address: testAddress})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestRequestAPIError(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"not found"}`))
+	}))
+	_, err := client.Hotspot().Get(&HotspotInput{Address: testAddress})
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrServer))
+
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Equal(t, "/hotspots/"+testAddress, apiErr.Path)
+		assert.Equal(t, "req-123", apiErr.RequestID)
+		assert.Equal(t, "not found", apiErr.Message)
+		assert.False(t, apiErr.Retryable())
+	}
+}
+
+func TestAPIErrorRetryable(t *testing.T) {
+	assert.True(t, (&APIError{StatusCode: http.StatusTooManyRequests}).Retryable())
+	assert.True(t, (&APIError{StatusCode: http.StatusServiceUnavailable}).Retryable())
+	assert.False(t, (&APIError{StatusCode: http.StatusBadRequest}).Retryable())
+	assert.True(t, errors.Is(&APIError{StatusCode: http.StatusTooManyRequests}, ErrRateLimited))
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestClientURL(t *testing.T) {
+	var path string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"data":{"height":10}}`))
+	}))
+	defer server.Close()
+
+	// no scheme defaults to https
+	client := ClientWithOptions(WithURL(strings.TrimPrefix(server.URL, "https://")+"/mirror/v1/"), WithHTTPClient(server.Client()))
+	height, err := client.Block().CurrentHeight(&BlockCursorInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, 10, height.Data.Height)
+	assert.Equal(t, "/mirror/v1/blocks/height", path)
+
+	_, err = ClientWithOptions(WithURL("http://")).Block().CurrentHeight(&BlockCursorInput{})
+	assert.Error(t, err)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientTransport(t *testing.T) {
+	shared := &http.Client{}
+	var requested string
+	client := ClientWithOptions(
+		WithHTTPClient(shared),
+		WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			requested = req.URL.String()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"data":{"token_supply":1.5}}`)),
+				Header:     make(http.Header),
+			}, nil
+		})),
+	)
+	supply, err := client.Stat().TokenSupply()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, supply.Data.TokenSupply)
+	assert.Equal(t, "https://api.helium.io/v1/stats/token_supply", requested)
+	assert.Nil(t, shared.Transport)
+}
@@ -0,0 +1,239 @@
+package helium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// DCBurn handles the data credits burned on chain at /dc_burns
+type DCBurn struct {
+	c *Client
+}
+
+// DCBurn returns the DCBurn client
+func (c *Client) DCBurn() *DCBurn {
+	return &DCBurn{c}
+}
+
+// BurnType is what data credits were burned for
+type BurnType string
+
+const (
+	BurnAddGateway     BurnType = "add_gateway"
+	BurnAssertLocation BurnType = "assert_location"
+	BurnFee            BurnType = "fee"
+	BurnOui            BurnType = "oui"
+	BurnRouting        BurnType = "routing"
+	BurnStateChannel   BurnType = "state_channel"
+)
+
+type DCBurns struct {
+	Data   []DCBurnData `json:"data"`
+	Cursor string       `json:"cursor"`
+}
+
+type DCBurnData struct {
+	Block           int       `json:"block"`
+	TransactionHash string    `json:"transaction_hash"`
+	Actor           Address   `json:"actor"`
+	Type            BurnType  `json:"type"`
+	Amount          DC        `json:"amount"`
+	OraclePrice     int       `json:"oracle_price"`
+	Time            Timestamp `json:"time"`
+}
+
+// DCBurnTotals is the data credits burned for each BurnType
+type DCBurnTotals struct {
+	AddGateway     DC `json:"add_gateway"`
+	AssertLocation DC `json:"assert_location"`
+	Fee            DC `json:"fee"`
+	Oui            DC `json:"oui"`
+	Routing        DC `json:"routing"`
+	StateChannel   DC `json:"state_channel"`
+}
+
+// Total returns the data credits burned for every BurnType
+func (t DCBurnTotals) Total() DC {
+	return t.AddGateway + t.AssertLocation + t.Fee + t.Oui + t.Routing + t.StateChannel
+}
+
+type DCBurnSum struct {
+	Data DCBurnTotals `json:"data"`
+	Meta Meta         `json:"meta"`
+}
+
+type DCBurnBuckets struct {
+	Data []DCBurnBucket `json:"data"`
+	Meta Meta           `json:"meta"`
+}
+
+// DCBurnBucket is the data credits burned in one bucket of a sum, starting at Timestamp
+type DCBurnBucket struct {
+	DCBurnTotals
+	Timestamp Timestamp `json:"timestamp"`
+}
+
+type DCBurnStats struct {
+	Data DCBurnStatsData `json:"data"`
+}
+
+type DCBurnStatsData struct {
+	LastDay   DCBurnTotals `json:"last_day"`
+	LastWeek  DCBurnTotals `json:"last_week"`
+	LastMonth DCBurnTotals `json:"last_month"`
+}
+
+// DCBurnValue is the value of burned data credits, HNT is what was burned for them
+// at the oracle price effective at the height of each burn
+type DCBurnValue struct {
+	DC  DC
+	USD float64
+	HNT HNT
+}
+
+type DCBurnListInput struct {
+	MinTime TimeBound
+	MaxTime TimeBound
+	Cursor  string
+}
+
+type DCBurnSumInput struct {
+	MinTime TimeBound
+	MaxTime TimeBound
+}
+
+// List Lists data credit burns, newest first.
+func (d *DCBurn) List(input *DCBurnListInput) (*DCBurns, error) {
+	return d.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (d *DCBurn) ListWithContext(ctx context.Context, input *DCBurnListInput) (*DCBurns, error) {
+	params := make(map[string]string)
+	params["cursor"] = input.Cursor
+	timeParams(params, input.MinTime, input.MaxTime)
+	resp, err := d.c.RequestContext(ctx, http.MethodGet, "/dc_burns", new(bytes.Buffer), params)
+	if err != nil {
+		return &DCBurns{}, err
+	}
+	defer resp.Body.Close()
+
+	var dcBurns *DCBurns
+	err = json.NewDecoder(resp.Body).Decode(&dcBurns)
+	if err != nil {
+		return &DCBurns{}, err
+	}
+	return dcBurns, nil
+}
+
+// ListAll iterates over the data credit burns in a given timeframe, newest first.
+func (d *DCBurn) ListAll(input *DCBurnListInput, opts ...PagerOption) *DCBurnPager {
+	return NewDCBurnPager(func(ctx context.Context, cursor string) (*DCBurns, error) {
+		page := *input
+		page.Cursor = cursor
+		return d.ListWithContext(ctx, &page)
+	}, opts...)
+}
+
+// Sum Returns the data credits burned for each burn type in a given timeframe.
+func (d *DCBurn) Sum(input *DCBurnSumInput) (*DCBurnSum, error) {
+	return d.SumWithContext(context.Background(), input)
+}
+
+// SumWithContext is Sum with a caller supplied context.
+func (d *DCBurn) SumWithContext(ctx context.Context, input *DCBurnSumInput) (*DCBurnSum, error) {
+	params := make(map[string]string)
+	timeParams(params, input.MinTime, input.MaxTime)
+	resp, err := d.c.RequestContext(ctx, http.MethodGet, "/dc_burns/sum", new(bytes.Buffer), params)
+	if err != nil {
+		return &DCBurnSum{}, err
+	}
+	defer resp.Body.Close()
+
+	var dcBurnSum *DCBurnSum
+	err = json.NewDecoder(resp.Body).Decode(&dcBurnSum)
+	if err != nil {
+		return &DCBurnSum{}, err
+	}
+	return dcBurnSum, nil
+}
+
+// SumBuckets Returns the data credits burned for each burn type in a given timeframe, summed per bucket.
+func (d *DCBurn) SumBuckets(input *DCBurnSumInput, bucket Bucket) (*DCBurnBuckets, error) {
+	return d.SumBucketsWithContext(context.Background(), input, bucket)
+}
+
+// SumBucketsWithContext is SumBuckets with a caller supplied context.
+func (d *DCBurn) SumBucketsWithContext(ctx context.Context, input *DCBurnSumInput, bucket Bucket) (*DCBurnBuckets, error) {
+	params := make(map[string]string)
+	err := bucketParams(params, input.MinTime, input.MaxTime, bucket)
+	if err != nil {
+		return &DCBurnBuckets{}, err
+	}
+	resp, err := d.c.RequestContext(ctx, http.MethodGet, "/dc_burns/sum", new(bytes.Buffer), params)
+	if err != nil {
+		return &DCBurnBuckets{}, err
+	}
+	defer resp.Body.Close()
+
+	var dcBurnBuckets *DCBurnBuckets
+	err = json.NewDecoder(resp.Body).Decode(&dcBurnBuckets)
+	if err != nil {
+		return &DCBurnBuckets{}, err
+	}
+	return dcBurnBuckets, nil
+}
+
+// Stats Returns the data credits burned for each burn type over the last day, week and month.
+func (d *DCBurn) Stats() (*DCBurnStats, error) {
+	return d.StatsWithContext(context.Background())
+}
+
+// StatsWithContext is Stats with a caller supplied context.
+func (d *DCBurn) StatsWithContext(ctx context.Context) (*DCBurnStats, error) {
+	resp, err := d.c.RequestContext(ctx, http.MethodGet, "/dc_burns/stats", new(bytes.Buffer), nil)
+	if err != nil {
+		return &DCBurnStats{}, err
+	}
+	defer resp.Body.Close()
+
+	var dcBurnStats *DCBurnStats
+	err = json.NewDecoder(resp.Body).Decode(&dcBurnStats)
+	if err != nil {
+		return &DCBurnStats{}, err
+	}
+	return dcBurnStats, nil
+}
+
+// Value Converts burned data credits to US dollars and to the HNT burned for them at the oracle price of each
+// burn. Burns without an OraclePrice look up the price effective at their height, once per height.
+func (d *DCBurn) Value(burns []DCBurnData) (*DCBurnValue, error) {
+	return d.ValueWithContext(context.Background(), burns)
+}
+
+// ValueWithContext is Value with a caller supplied context.
+func (d *DCBurn) ValueWithContext(ctx context.Context, burns []DCBurnData) (*DCBurnValue, error) {
+	prices := make(map[int]int)
+	value := &DCBurnValue{}
+	for _, burn := range burns {
+		price, ok := burn.OraclePrice, burn.OraclePrice != 0
+		if !ok {
+			price, ok = prices[burn.Block]
+		}
+		if !ok {
+			oraclePrice, err := d.c.Oracle().BlockWithContext(ctx, &OraclePriceBlockInput{ID: strconv.Itoa(burn.Block)})
+			if err != nil {
+				return &DCBurnValue{}, err
+			}
+			price = oraclePrice.Data.Price
+			prices[burn.Block] = price
+		}
+		value.DC += burn.Amount
+		value.HNT += burn.Amount.HNT(price)
+	}
+	value.USD = value.DC.USD()
+	return value, nil
+}
@@ -0,0 +1,52 @@
+package helium
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDCBurnSumBuckets(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/dc_burns/sum", r.URL.Path)
+		assert.Equal(t, "day", r.URL.Query().Get("bucket"))
+		w.Write([]byte(`{"data":[{"timestamp":"2021-06-01T00:00:00.000000Z","state_channel":1200,"fee":350000,"add_gateway":4000000}],` +
+			`"meta":{"bucket":"day"}}`))
+	}))
+
+	buckets, err := client.DCBurn().SumBuckets(&DCBurnSumInput{MinTime: Ago(24 * time.Hour)}, BucketDay)
+	assert.NoError(t, err)
+	assert.Equal(t, DC(1200), buckets.Data[0].StateChannel)
+	assert.Equal(t, DC(4351200), buckets.Data[0].Total())
+	assert.Equal(t, 2021, buckets.Data[0].Timestamp.Year())
+}
+
+func TestDCBurnValue(t *testing.T) {
+	var lookups []string
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lookups = append(lookups, r.URL.Path)
+		switch r.URL.Path {
+		case "/oracle/prices/100":
+			w.Write([]byte(`{"data":{"price":1000000000,"block":90}}`))
+		case "/oracle/prices/200":
+			w.Write([]byte(`{"data":{"price":2000000000,"block":190}}`))
+		}
+	}))
+
+	burns := []DCBurnData{
+		{Block: 100, Type: BurnStateChannel, Amount: 100000},
+		{Block: 100, Type: BurnFee, Amount: 35000},
+		{Block: 200, Type: BurnAddGateway, Amount: 4000000},
+		// burns from /dc_burns carry their oracle price and need no lookup
+		{Block: 300, Type: BurnFee, Amount: 100000, OraclePrice: 500000000},
+	}
+	value, err := client.DCBurn().Value(burns)
+	assert.NoError(t, err)
+	assert.Equal(t, DC(4235000), value.DC)
+	assert.Equal(t, 42.35, value.USD)
+	// $1.35 at $10 per HNT, $40 at $20 per HNT and $1 at $5 per HNT
+	assert.Equal(t, HNT(233500000), value.HNT)
+	assert.Equal(t, []string{"/oracle/prices/100", "/oracle/prices/200"}, lookups)
+}
@@ -0,0 +1,57 @@
+package helium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Election handles the consensus group elections of the whole network at /elections
+type Election struct {
+	c *Client
+}
+
+// Election returns the Election client
+func (c *Client) Election() *Election {
+	return &Election{c}
+}
+
+type ElectionListInput struct {
+	MinTime TimeBound
+	MaxTime TimeBound
+	Cursor  string
+}
+
+// List Lists the consensus group transactions in a given timeframe, newest first.
+func (e *Election) List(input *ElectionListInput) (*Elections, error) {
+	return e.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (e *Election) ListWithContext(ctx context.Context, input *ElectionListInput) (*Elections, error) {
+	params := make(map[string]string)
+	params["cursor"] = input.Cursor
+	timeParams(params, input.MinTime, input.MaxTime)
+	resp, err := e.c.RequestContext(ctx, http.MethodGet, "/elections", new(bytes.Buffer), params)
+	if err != nil {
+		return &Elections{}, err
+	}
+	defer resp.Body.Close()
+
+	var elections *Elections
+	err = json.NewDecoder(resp.Body).Decode(&elections)
+	if err != nil {
+		return &Elections{}, err
+	}
+	return elections, nil
+}
+
+// ListAll iterates over the consensus group transactions in a given timeframe.
+func (e *Election) ListAll(input *ElectionListInput, opts ...PagerOption) *ElectionPager {
+	return NewElectionPager(func(ctx context.Context, cursor string) (*Elections, error) {
+		page := *input
+		page.Cursor = cursor
+		return e.ListWithContext(ctx, &page)
+	}, opts...)
+}
@@ -0,0 +1,126 @@
+package helium
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// maxErrorBody limits how much of an error response body is kept on an APIError
+const maxErrorBody = 64 * 1024
+
+var (
+	// ErrBadRequest matches APIErrors for 400 responses
+	ErrBadRequest = errors.New("helium: bad request")
+	// ErrUnauthorized matches APIErrors for 401 and 403 responses
+	ErrUnauthorized = errors.New("helium: unauthorized")
+	// ErrNotFound matches APIErrors for 404 responses, e.g. an unknown address or hash
+	ErrNotFound = errors.New("helium: not found")
+	// ErrRateLimited matches APIErrors for 429 responses
+	ErrRateLimited = errors.New("helium: rate limited")
+	// ErrServer matches APIErrors for 5xx responses
+	ErrServer = errors.New("helium: server error")
+)
+
+// requestIDHeaders are checked in order for an identifier of the failed request
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "Cf-Ray"}
+
+// APIError is returned when the api responds with a non 2xx status
+type APIError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	// Path is the endpoint path the request was made to, e.g. /hotspots/:address
+	Path      string
+	RequestID string
+	// Message is the error decoded from a JSON error body, if there was one
+	Message string
+	// RetryAfter is the delay requested by a Retry-After header
+	RetryAfter time.Duration
+	// Body is the raw response body
+	Body   []byte
+	Header http.Header
+}
+
+type apiErrorBody struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// newAPIError builds an APIError from a failed response, it does not close the body
+func newAPIError(method string, path string, resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Method:     method,
+		Path:       path,
+		Header:     resp.Header,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+	for _, header := range requestIDHeaders {
+		if id := resp.Header.Get(header); len(id) > 0 {
+			apiErr.RequestID = id
+			break
+		}
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if err != nil {
+		return apiErr
+	}
+	apiErr.Body = body
+
+	var errorBody apiErrorBody
+	if json.Unmarshal(body, &errorBody) == nil {
+		apiErr.Message = errorBody.Error
+		if len(apiErr.Message) == 0 {
+			apiErr.Message = errorBody.Message
+		}
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("helium: %s %s returned %s", e.Method, e.Path, e.Status)
+	if len(e.Message) > 0 {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	if len(e.RequestID) > 0 {
+		msg = fmt.Sprintf("%s (request id %s)", msg, e.RequestID)
+	}
+	return msg
+}
+
+// Is allows APIErrors to be matched against the sentinel errors with errors.Is
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+// Retryable reports whether the request may succeed if it is made again
+func (e *APIError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
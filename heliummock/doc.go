@@ -0,0 +1,14 @@
+// Package heliummock provides mock implementations of the helium service interfaces, one per interface,
+// with a Func field per method and a Calls method recording the arguments of every call.
+//
+//	hotspots := &heliummock.HotspotAPIMock{
+//		GetFunc: func(input *helium.HotspotInput) (*helium.HotspotInfo, error) {
+//			return nil, helium.ErrNotFound
+//		},
+//	}
+//	api := &heliummock.APIMock{
+//		HotspotFunc: func() helium.HotspotAPI { return hotspots },
+//	}
+package heliummock
+
+//go:generate go run ../internal/mockgen -source ../api.go -out mocks.go
@@ -0,0 +1,8792 @@
+// Code generated by internal/mockgen from api.go; DO NOT EDIT.
+
+package heliummock
+
+import (
+	"context"
+	"sync"
+
+	helium "github.com/dougkirkley/helium-go"
+)
+
+// Ensure, that APIMock does implement helium.API.
+var _ helium.API = &APIMock{}
+
+// APIMock is a mock implementation of helium.API.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type APIMock struct {
+	// AccountFunc mocks the Account method.
+	AccountFunc func() helium.AccountAPI
+
+	// HotspotFunc mocks the Hotspot method.
+	HotspotFunc func() helium.HotspotAPI
+
+	// BlockFunc mocks the Block method.
+	BlockFunc func() helium.BlockAPI
+
+	// ValidatorFunc mocks the Validator method.
+	ValidatorFunc func() helium.ValidatorAPI
+
+	// OracleFunc mocks the Oracle method.
+	OracleFunc func() helium.OracleAPI
+
+	// CityFunc mocks the City method.
+	CityFunc func() helium.CityAPI
+
+	// LocationFunc mocks the Location method.
+	LocationFunc func() helium.LocationAPI
+
+	// StatFunc mocks the Stat method.
+	StatFunc func() helium.StatAPI
+
+	// TransactionFunc mocks the Transaction method.
+	TransactionFunc func() helium.TransactionAPI
+
+	// PendingTransactionFunc mocks the PendingTransaction method.
+	PendingTransactionFunc func() helium.PendingTransactionAPI
+
+	// RewardFunc mocks the Reward method.
+	RewardFunc func() helium.RewardAPI
+
+	// VarsFunc mocks the Vars method.
+	VarsFunc func() helium.VarsAPI
+
+	// OuiFunc mocks the Oui method.
+	OuiFunc func() helium.OuiAPI
+
+	// StateChannelFunc mocks the StateChannel method.
+	StateChannelFunc func() helium.StateChannelAPI
+
+	// DCBurnFunc mocks the DCBurn method.
+	DCBurnFunc func() helium.DCBurnAPI
+
+	// ChallengeFunc mocks the Challenge method.
+	ChallengeFunc func() helium.ChallengeAPI
+
+	// ElectionFunc mocks the Election method.
+	ElectionFunc func() helium.ElectionAPI
+
+	calls struct {
+		Account []struct {
+		}
+		Hotspot []struct {
+		}
+		Block []struct {
+		}
+		Validator []struct {
+		}
+		Oracle []struct {
+		}
+		City []struct {
+		}
+		Location []struct {
+		}
+		Stat []struct {
+		}
+		Transaction []struct {
+		}
+		PendingTransaction []struct {
+		}
+		Reward []struct {
+		}
+		Vars []struct {
+		}
+		Oui []struct {
+		}
+		StateChannel []struct {
+		}
+		DCBurn []struct {
+		}
+		Challenge []struct {
+		}
+		Election []struct {
+		}
+	}
+	lockAccount            sync.RWMutex
+	lockHotspot            sync.RWMutex
+	lockBlock              sync.RWMutex
+	lockValidator          sync.RWMutex
+	lockOracle             sync.RWMutex
+	lockCity               sync.RWMutex
+	lockLocation           sync.RWMutex
+	lockStat               sync.RWMutex
+	lockTransaction        sync.RWMutex
+	lockPendingTransaction sync.RWMutex
+	lockReward             sync.RWMutex
+	lockVars               sync.RWMutex
+	lockOui                sync.RWMutex
+	lockStateChannel       sync.RWMutex
+	lockDCBurn             sync.RWMutex
+	lockChallenge          sync.RWMutex
+	lockElection           sync.RWMutex
+}
+
+// Account calls AccountFunc.
+func (mock *APIMock) Account() helium.AccountAPI {
+	if mock.AccountFunc == nil {
+		panic("APIMock.AccountFunc: method is nil but API.Account was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockAccount.Lock()
+	mock.calls.Account = append(mock.calls.Account, callInfo)
+	mock.lockAccount.Unlock()
+	return mock.AccountFunc()
+}
+
+// AccountCalls gets all the calls that were made to Account.
+func (mock *APIMock) AccountCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockAccount.RLock()
+	calls = mock.calls.Account
+	mock.lockAccount.RUnlock()
+	return calls
+}
+
+// Hotspot calls HotspotFunc.
+func (mock *APIMock) Hotspot() helium.HotspotAPI {
+	if mock.HotspotFunc == nil {
+		panic("APIMock.HotspotFunc: method is nil but API.Hotspot was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockHotspot.Lock()
+	mock.calls.Hotspot = append(mock.calls.Hotspot, callInfo)
+	mock.lockHotspot.Unlock()
+	return mock.HotspotFunc()
+}
+
+// HotspotCalls gets all the calls that were made to Hotspot.
+func (mock *APIMock) HotspotCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockHotspot.RLock()
+	calls = mock.calls.Hotspot
+	mock.lockHotspot.RUnlock()
+	return calls
+}
+
+// Block calls BlockFunc.
+func (mock *APIMock) Block() helium.BlockAPI {
+	if mock.BlockFunc == nil {
+		panic("APIMock.BlockFunc: method is nil but API.Block was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockBlock.Lock()
+	mock.calls.Block = append(mock.calls.Block, callInfo)
+	mock.lockBlock.Unlock()
+	return mock.BlockFunc()
+}
+
+// BlockCalls gets all the calls that were made to Block.
+func (mock *APIMock) BlockCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockBlock.RLock()
+	calls = mock.calls.Block
+	mock.lockBlock.RUnlock()
+	return calls
+}
+
+// Validator calls ValidatorFunc.
+func (mock *APIMock) Validator() helium.ValidatorAPI {
+	if mock.ValidatorFunc == nil {
+		panic("APIMock.ValidatorFunc: method is nil but API.Validator was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockValidator.Lock()
+	mock.calls.Validator = append(mock.calls.Validator, callInfo)
+	mock.lockValidator.Unlock()
+	return mock.ValidatorFunc()
+}
+
+// ValidatorCalls gets all the calls that were made to Validator.
+func (mock *APIMock) ValidatorCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockValidator.RLock()
+	calls = mock.calls.Validator
+	mock.lockValidator.RUnlock()
+	return calls
+}
+
+// Oracle calls OracleFunc.
+func (mock *APIMock) Oracle() helium.OracleAPI {
+	if mock.OracleFunc == nil {
+		panic("APIMock.OracleFunc: method is nil but API.Oracle was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockOracle.Lock()
+	mock.calls.Oracle = append(mock.calls.Oracle, callInfo)
+	mock.lockOracle.Unlock()
+	return mock.OracleFunc()
+}
+
+// OracleCalls gets all the calls that were made to Oracle.
+func (mock *APIMock) OracleCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockOracle.RLock()
+	calls = mock.calls.Oracle
+	mock.lockOracle.RUnlock()
+	return calls
+}
+
+// City calls CityFunc.
+func (mock *APIMock) City() helium.CityAPI {
+	if mock.CityFunc == nil {
+		panic("APIMock.CityFunc: method is nil but API.City was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockCity.Lock()
+	mock.calls.City = append(mock.calls.City, callInfo)
+	mock.lockCity.Unlock()
+	return mock.CityFunc()
+}
+
+// CityCalls gets all the calls that were made to City.
+func (mock *APIMock) CityCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockCity.RLock()
+	calls = mock.calls.City
+	mock.lockCity.RUnlock()
+	return calls
+}
+
+// Location calls LocationFunc.
+func (mock *APIMock) Location() helium.LocationAPI {
+	if mock.LocationFunc == nil {
+		panic("APIMock.LocationFunc: method is nil but API.Location was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockLocation.Lock()
+	mock.calls.Location = append(mock.calls.Location, callInfo)
+	mock.lockLocation.Unlock()
+	return mock.LocationFunc()
+}
+
+// LocationCalls gets all the calls that were made to Location.
+func (mock *APIMock) LocationCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockLocation.RLock()
+	calls = mock.calls.Location
+	mock.lockLocation.RUnlock()
+	return calls
+}
+
+// Stat calls StatFunc.
+func (mock *APIMock) Stat() helium.StatAPI {
+	if mock.StatFunc == nil {
+		panic("APIMock.StatFunc: method is nil but API.Stat was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockStat.Lock()
+	mock.calls.Stat = append(mock.calls.Stat, callInfo)
+	mock.lockStat.Unlock()
+	return mock.StatFunc()
+}
+
+// StatCalls gets all the calls that were made to Stat.
+func (mock *APIMock) StatCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockStat.RLock()
+	calls = mock.calls.Stat
+	mock.lockStat.RUnlock()
+	return calls
+}
+
+// Transaction calls TransactionFunc.
+func (mock *APIMock) Transaction() helium.TransactionAPI {
+	if mock.TransactionFunc == nil {
+		panic("APIMock.TransactionFunc: method is nil but API.Transaction was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockTransaction.Lock()
+	mock.calls.Transaction = append(mock.calls.Transaction, callInfo)
+	mock.lockTransaction.Unlock()
+	return mock.TransactionFunc()
+}
+
+// TransactionCalls gets all the calls that were made to Transaction.
+func (mock *APIMock) TransactionCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockTransaction.RLock()
+	calls = mock.calls.Transaction
+	mock.lockTransaction.RUnlock()
+	return calls
+}
+
+// PendingTransaction calls PendingTransactionFunc.
+func (mock *APIMock) PendingTransaction() helium.PendingTransactionAPI {
+	if mock.PendingTransactionFunc == nil {
+		panic("APIMock.PendingTransactionFunc: method is nil but API.PendingTransaction was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockPendingTransaction.Lock()
+	mock.calls.PendingTransaction = append(mock.calls.PendingTransaction, callInfo)
+	mock.lockPendingTransaction.Unlock()
+	return mock.PendingTransactionFunc()
+}
+
+// PendingTransactionCalls gets all the calls that were made to PendingTransaction.
+func (mock *APIMock) PendingTransactionCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockPendingTransaction.RLock()
+	calls = mock.calls.PendingTransaction
+	mock.lockPendingTransaction.RUnlock()
+	return calls
+}
+
+// Reward calls RewardFunc.
+func (mock *APIMock) Reward() helium.RewardAPI {
+	if mock.RewardFunc == nil {
+		panic("APIMock.RewardFunc: method is nil but API.Reward was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockReward.Lock()
+	mock.calls.Reward = append(mock.calls.Reward, callInfo)
+	mock.lockReward.Unlock()
+	return mock.RewardFunc()
+}
+
+// RewardCalls gets all the calls that were made to Reward.
+func (mock *APIMock) RewardCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockReward.RLock()
+	calls = mock.calls.Reward
+	mock.lockReward.RUnlock()
+	return calls
+}
+
+// Vars calls VarsFunc.
+func (mock *APIMock) Vars() helium.VarsAPI {
+	if mock.VarsFunc == nil {
+		panic("APIMock.VarsFunc: method is nil but API.Vars was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockVars.Lock()
+	mock.calls.Vars = append(mock.calls.Vars, callInfo)
+	mock.lockVars.Unlock()
+	return mock.VarsFunc()
+}
+
+// VarsCalls gets all the calls that were made to Vars.
+func (mock *APIMock) VarsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockVars.RLock()
+	calls = mock.calls.Vars
+	mock.lockVars.RUnlock()
+	return calls
+}
+
+// Oui calls OuiFunc.
+func (mock *APIMock) Oui() helium.OuiAPI {
+	if mock.OuiFunc == nil {
+		panic("APIMock.OuiFunc: method is nil but API.Oui was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockOui.Lock()
+	mock.calls.Oui = append(mock.calls.Oui, callInfo)
+	mock.lockOui.Unlock()
+	return mock.OuiFunc()
+}
+
+// OuiCalls gets all the calls that were made to Oui.
+func (mock *APIMock) OuiCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockOui.RLock()
+	calls = mock.calls.Oui
+	mock.lockOui.RUnlock()
+	return calls
+}
+
+// StateChannel calls StateChannelFunc.
+func (mock *APIMock) StateChannel() helium.StateChannelAPI {
+	if mock.StateChannelFunc == nil {
+		panic("APIMock.StateChannelFunc: method is nil but API.StateChannel was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockStateChannel.Lock()
+	mock.calls.StateChannel = append(mock.calls.StateChannel, callInfo)
+	mock.lockStateChannel.Unlock()
+	return mock.StateChannelFunc()
+}
+
+// StateChannelCalls gets all the calls that were made to StateChannel.
+func (mock *APIMock) StateChannelCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockStateChannel.RLock()
+	calls = mock.calls.StateChannel
+	mock.lockStateChannel.RUnlock()
+	return calls
+}
+
+// DCBurn calls DCBurnFunc.
+func (mock *APIMock) DCBurn() helium.DCBurnAPI {
+	if mock.DCBurnFunc == nil {
+		panic("APIMock.DCBurnFunc: method is nil but API.DCBurn was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDCBurn.Lock()
+	mock.calls.DCBurn = append(mock.calls.DCBurn, callInfo)
+	mock.lockDCBurn.Unlock()
+	return mock.DCBurnFunc()
+}
+
+// DCBurnCalls gets all the calls that were made to DCBurn.
+func (mock *APIMock) DCBurnCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDCBurn.RLock()
+	calls = mock.calls.DCBurn
+	mock.lockDCBurn.RUnlock()
+	return calls
+}
+
+// Challenge calls ChallengeFunc.
+func (mock *APIMock) Challenge() helium.ChallengeAPI {
+	if mock.ChallengeFunc == nil {
+		panic("APIMock.ChallengeFunc: method is nil but API.Challenge was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockChallenge.Lock()
+	mock.calls.Challenge = append(mock.calls.Challenge, callInfo)
+	mock.lockChallenge.Unlock()
+	return mock.ChallengeFunc()
+}
+
+// ChallengeCalls gets all the calls that were made to Challenge.
+func (mock *APIMock) ChallengeCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockChallenge.RLock()
+	calls = mock.calls.Challenge
+	mock.lockChallenge.RUnlock()
+	return calls
+}
+
+// Election calls ElectionFunc.
+func (mock *APIMock) Election() helium.ElectionAPI {
+	if mock.ElectionFunc == nil {
+		panic("APIMock.ElectionFunc: method is nil but API.Election was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockElection.Lock()
+	mock.calls.Election = append(mock.calls.Election, callInfo)
+	mock.lockElection.Unlock()
+	return mock.ElectionFunc()
+}
+
+// ElectionCalls gets all the calls that were made to Election.
+func (mock *APIMock) ElectionCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockElection.RLock()
+	calls = mock.calls.Election
+	mock.lockElection.RUnlock()
+	return calls
+}
+
+// Ensure, that AccountAPIMock does implement helium.AccountAPI.
+var _ helium.AccountAPI = &AccountAPIMock{}
+
+// AccountAPIMock is a mock implementation of helium.AccountAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type AccountAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.AccountListInput) (*helium.Accounts, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.AccountListInput) (*helium.Accounts, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(opts ...helium.PagerOption) *helium.AccountPager
+
+	// RichestFunc mocks the Richest method.
+	RichestFunc func(input *helium.AccountRichestInput) (*helium.Accounts, error)
+
+	// RichestWithContextFunc mocks the RichestWithContext method.
+	RichestWithContextFunc func(ctx context.Context, input *helium.AccountRichestInput) (*helium.Accounts, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(input *helium.AccountInput) (*helium.UserAccount, error)
+
+	// GetWithContextFunc mocks the GetWithContext method.
+	GetWithContextFunc func(ctx context.Context, input *helium.AccountInput) (*helium.UserAccount, error)
+
+	// HotspotsFunc mocks the Hotspots method.
+	HotspotsFunc func(input *helium.AccountInput) (*helium.Hotspots, error)
+
+	// HotspotsWithContextFunc mocks the HotspotsWithContext method.
+	HotspotsWithContextFunc func(ctx context.Context, input *helium.AccountInput) (*helium.Hotspots, error)
+
+	// HotspotsAllFunc mocks the HotspotsAll method.
+	HotspotsAllFunc func(input *helium.AccountInput, opts ...helium.PagerOption) *helium.HotspotPager
+
+	// OuisFunc mocks the Ouis method.
+	OuisFunc func(input *helium.AccountInput) (*helium.Ouis, error)
+
+	// OuisWithContextFunc mocks the OuisWithContext method.
+	OuisWithContextFunc func(ctx context.Context, input *helium.AccountInput) (*helium.Ouis, error)
+
+	// OuisAllFunc mocks the OuisAll method.
+	OuisAllFunc func(input *helium.AccountInput, opts ...helium.PagerOption) *helium.OuiPager
+
+	// ActivityFunc mocks the Activity method.
+	ActivityFunc func(input *helium.AccountActivityInput) (*helium.Activity, error)
+
+	// ActivityWithContextFunc mocks the ActivityWithContext method.
+	ActivityWithContextFunc func(ctx context.Context, input *helium.AccountActivityInput) (*helium.Activity, error)
+
+	// ActivityAllFunc mocks the ActivityAll method.
+	ActivityAllFunc func(input *helium.AccountActivityInput, opts ...helium.PagerOption) *helium.ActivityPager
+
+	// RolesFunc mocks the Roles method.
+	RolesFunc func(input *helium.AccountActivityInput) (*helium.Roles, error)
+
+	// RolesWithContextFunc mocks the RolesWithContext method.
+	RolesWithContextFunc func(ctx context.Context, input *helium.AccountActivityInput) (*helium.Roles, error)
+
+	// RolesAllFunc mocks the RolesAll method.
+	RolesAllFunc func(input *helium.AccountActivityInput, opts ...helium.PagerOption) *helium.RolePager
+
+	// ActivityCountFunc mocks the ActivityCount method.
+	ActivityCountFunc func(input *helium.AccountInput) (*helium.ActivityCount, error)
+
+	// ActivityCountWithContextFunc mocks the ActivityCountWithContext method.
+	ActivityCountWithContextFunc func(ctx context.Context, input *helium.AccountInput) (*helium.ActivityCount, error)
+
+	// ElectionsFunc mocks the Elections method.
+	ElectionsFunc func(input *helium.AccountInput) (*helium.Elections, error)
+
+	// ElectionsWithContextFunc mocks the ElectionsWithContext method.
+	ElectionsWithContextFunc func(ctx context.Context, input *helium.AccountInput) (*helium.Elections, error)
+
+	// ElectionsAllFunc mocks the ElectionsAll method.
+	ElectionsAllFunc func(input *helium.AccountInput, opts ...helium.PagerOption) *helium.ElectionPager
+
+	// ChallengesFunc mocks the Challenges method.
+	ChallengesFunc func(input *helium.AccountInput) (*helium.Challenges, error)
+
+	// ChallengesWithContextFunc mocks the ChallengesWithContext method.
+	ChallengesWithContextFunc func(ctx context.Context, input *helium.AccountInput) (*helium.Challenges, error)
+
+	// ChallengesAllFunc mocks the ChallengesAll method.
+	ChallengesAllFunc func(input *helium.AccountInput, opts ...helium.PagerOption) *helium.ChallengePager
+
+	// PendingTransactionsFunc mocks the PendingTransactions method.
+	PendingTransactionsFunc func(input *helium.AccountInput) (*helium.PendingTransactions, error)
+
+	// PendingTransactionsWithContextFunc mocks the PendingTransactionsWithContext method.
+	PendingTransactionsWithContextFunc func(ctx context.Context, input *helium.AccountInput) (*helium.PendingTransactions, error)
+
+	// PendingTransactionsAllFunc mocks the PendingTransactionsAll method.
+	PendingTransactionsAllFunc func(input *helium.AccountInput, opts ...helium.PagerOption) *helium.PendingTransactionPager
+
+	// RewardsFunc mocks the Rewards method.
+	RewardsFunc func(input *helium.AccountRewardsInput) (*helium.Rewards, error)
+
+	// RewardsWithContextFunc mocks the RewardsWithContext method.
+	RewardsWithContextFunc func(ctx context.Context, input *helium.AccountRewardsInput) (*helium.Rewards, error)
+
+	// RewardsAllFunc mocks the RewardsAll method.
+	RewardsAllFunc func(input *helium.AccountRewardsInput, opts ...helium.PagerOption) *helium.RewardPager
+
+	// RewardSumFunc mocks the RewardSum method.
+	RewardSumFunc func(input *helium.AccountRewardsInput) (*helium.RewardSum, error)
+
+	// RewardSumWithContextFunc mocks the RewardSumWithContext method.
+	RewardSumWithContextFunc func(ctx context.Context, input *helium.AccountRewardsInput) (*helium.RewardSum, error)
+
+	// RewardSumBucketsFunc mocks the RewardSumBuckets method.
+	RewardSumBucketsFunc func(input *helium.AccountRewardsInput, bucket helium.Bucket) (*helium.RewardBuckets, error)
+
+	// RewardSumBucketsWithContextFunc mocks the RewardSumBucketsWithContext method.
+	RewardSumBucketsWithContextFunc func(ctx context.Context, input *helium.AccountRewardsInput, bucket helium.Bucket) (*helium.RewardBuckets, error)
+
+	// StatsFunc mocks the Stats method.
+	StatsFunc func(input *helium.AccountInput) (*helium.AccountStats, error)
+
+	// StatsWithContextFunc mocks the StatsWithContext method.
+	StatsWithContextFunc func(ctx context.Context, input *helium.AccountInput) (*helium.AccountStats, error)
+
+	calls struct {
+		List []struct {
+			Input *helium.AccountListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountListInput
+		}
+		ListAll []struct {
+			Opts []helium.PagerOption
+		}
+		Richest []struct {
+			Input *helium.AccountRichestInput
+		}
+		RichestWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountRichestInput
+		}
+		Get []struct {
+			Input *helium.AccountInput
+		}
+		GetWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountInput
+		}
+		Hotspots []struct {
+			Input *helium.AccountInput
+		}
+		HotspotsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountInput
+		}
+		HotspotsAll []struct {
+			Input *helium.AccountInput
+			Opts  []helium.PagerOption
+		}
+		Ouis []struct {
+			Input *helium.AccountInput
+		}
+		OuisWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountInput
+		}
+		OuisAll []struct {
+			Input *helium.AccountInput
+			Opts  []helium.PagerOption
+		}
+		Activity []struct {
+			Input *helium.AccountActivityInput
+		}
+		ActivityWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountActivityInput
+		}
+		ActivityAll []struct {
+			Input *helium.AccountActivityInput
+			Opts  []helium.PagerOption
+		}
+		Roles []struct {
+			Input *helium.AccountActivityInput
+		}
+		RolesWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountActivityInput
+		}
+		RolesAll []struct {
+			Input *helium.AccountActivityInput
+			Opts  []helium.PagerOption
+		}
+		ActivityCount []struct {
+			Input *helium.AccountInput
+		}
+		ActivityCountWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountInput
+		}
+		Elections []struct {
+			Input *helium.AccountInput
+		}
+		ElectionsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountInput
+		}
+		ElectionsAll []struct {
+			Input *helium.AccountInput
+			Opts  []helium.PagerOption
+		}
+		Challenges []struct {
+			Input *helium.AccountInput
+		}
+		ChallengesWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountInput
+		}
+		ChallengesAll []struct {
+			Input *helium.AccountInput
+			Opts  []helium.PagerOption
+		}
+		PendingTransactions []struct {
+			Input *helium.AccountInput
+		}
+		PendingTransactionsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountInput
+		}
+		PendingTransactionsAll []struct {
+			Input *helium.AccountInput
+			Opts  []helium.PagerOption
+		}
+		Rewards []struct {
+			Input *helium.AccountRewardsInput
+		}
+		RewardsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountRewardsInput
+		}
+		RewardsAll []struct {
+			Input *helium.AccountRewardsInput
+			Opts  []helium.PagerOption
+		}
+		RewardSum []struct {
+			Input *helium.AccountRewardsInput
+		}
+		RewardSumWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountRewardsInput
+		}
+		RewardSumBuckets []struct {
+			Input  *helium.AccountRewardsInput
+			Bucket helium.Bucket
+		}
+		RewardSumBucketsWithContext []struct {
+			Ctx    context.Context
+			Input  *helium.AccountRewardsInput
+			Bucket helium.Bucket
+		}
+		Stats []struct {
+			Input *helium.AccountInput
+		}
+		StatsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.AccountInput
+		}
+	}
+	lockList                           sync.RWMutex
+	lockListWithContext                sync.RWMutex
+	lockListAll                        sync.RWMutex
+	lockRichest                        sync.RWMutex
+	lockRichestWithContext             sync.RWMutex
+	lockGet                            sync.RWMutex
+	lockGetWithContext                 sync.RWMutex
+	lockHotspots                       sync.RWMutex
+	lockHotspotsWithContext            sync.RWMutex
+	lockHotspotsAll                    sync.RWMutex
+	lockOuis                           sync.RWMutex
+	lockOuisWithContext                sync.RWMutex
+	lockOuisAll                        sync.RWMutex
+	lockActivity                       sync.RWMutex
+	lockActivityWithContext            sync.RWMutex
+	lockActivityAll                    sync.RWMutex
+	lockRoles                          sync.RWMutex
+	lockRolesWithContext               sync.RWMutex
+	lockRolesAll                       sync.RWMutex
+	lockActivityCount                  sync.RWMutex
+	lockActivityCountWithContext       sync.RWMutex
+	lockElections                      sync.RWMutex
+	lockElectionsWithContext           sync.RWMutex
+	lockElectionsAll                   sync.RWMutex
+	lockChallenges                     sync.RWMutex
+	lockChallengesWithContext          sync.RWMutex
+	lockChallengesAll                  sync.RWMutex
+	lockPendingTransactions            sync.RWMutex
+	lockPendingTransactionsWithContext sync.RWMutex
+	lockPendingTransactionsAll         sync.RWMutex
+	lockRewards                        sync.RWMutex
+	lockRewardsWithContext             sync.RWMutex
+	lockRewardsAll                     sync.RWMutex
+	lockRewardSum                      sync.RWMutex
+	lockRewardSumWithContext           sync.RWMutex
+	lockRewardSumBuckets               sync.RWMutex
+	lockRewardSumBucketsWithContext    sync.RWMutex
+	lockStats                          sync.RWMutex
+	lockStatsWithContext               sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *AccountAPIMock) List(input *helium.AccountListInput) (*helium.Accounts, error) {
+	if mock.ListFunc == nil {
+		panic("AccountAPIMock.ListFunc: method is nil but AccountAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *AccountAPIMock) ListCalls() []struct {
+	Input *helium.AccountListInput
+} {
+	var calls []struct {
+		Input *helium.AccountListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *AccountAPIMock) ListWithContext(ctx context.Context, input *helium.AccountListInput) (*helium.Accounts, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("AccountAPIMock.ListWithContextFunc: method is nil but AccountAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *AccountAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *AccountAPIMock) ListAll(opts ...helium.PagerOption) *helium.AccountPager {
+	if mock.ListAllFunc == nil {
+		panic("AccountAPIMock.ListAllFunc: method is nil but AccountAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Opts []helium.PagerOption
+	}{
+		Opts: opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *AccountAPIMock) ListAllCalls() []struct {
+	Opts []helium.PagerOption
+} {
+	var calls []struct {
+		Opts []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Richest calls RichestFunc.
+func (mock *AccountAPIMock) Richest(input *helium.AccountRichestInput) (*helium.Accounts, error) {
+	if mock.RichestFunc == nil {
+		panic("AccountAPIMock.RichestFunc: method is nil but AccountAPI.Richest was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountRichestInput
+	}{
+		Input: input,
+	}
+	mock.lockRichest.Lock()
+	mock.calls.Richest = append(mock.calls.Richest, callInfo)
+	mock.lockRichest.Unlock()
+	return mock.RichestFunc(input)
+}
+
+// RichestCalls gets all the calls that were made to Richest.
+func (mock *AccountAPIMock) RichestCalls() []struct {
+	Input *helium.AccountRichestInput
+} {
+	var calls []struct {
+		Input *helium.AccountRichestInput
+	}
+	mock.lockRichest.RLock()
+	calls = mock.calls.Richest
+	mock.lockRichest.RUnlock()
+	return calls
+}
+
+// RichestWithContext calls RichestWithContextFunc.
+func (mock *AccountAPIMock) RichestWithContext(ctx context.Context, input *helium.AccountRichestInput) (*helium.Accounts, error) {
+	if mock.RichestWithContextFunc == nil {
+		panic("AccountAPIMock.RichestWithContextFunc: method is nil but AccountAPI.RichestWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountRichestInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRichestWithContext.Lock()
+	mock.calls.RichestWithContext = append(mock.calls.RichestWithContext, callInfo)
+	mock.lockRichestWithContext.Unlock()
+	return mock.RichestWithContextFunc(ctx, input)
+}
+
+// RichestWithContextCalls gets all the calls that were made to RichestWithContext.
+func (mock *AccountAPIMock) RichestWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountRichestInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountRichestInput
+	}
+	mock.lockRichestWithContext.RLock()
+	calls = mock.calls.RichestWithContext
+	mock.lockRichestWithContext.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *AccountAPIMock) Get(input *helium.AccountInput) (*helium.UserAccount, error) {
+	if mock.GetFunc == nil {
+		panic("AccountAPIMock.GetFunc: method is nil but AccountAPI.Get was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+	}{
+		Input: input,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(input)
+}
+
+// GetCalls gets all the calls that were made to Get.
+func (mock *AccountAPIMock) GetCalls() []struct {
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (mock *AccountAPIMock) GetWithContext(ctx context.Context, input *helium.AccountInput) (*helium.UserAccount, error) {
+	if mock.GetWithContextFunc == nil {
+		panic("AccountAPIMock.GetWithContextFunc: method is nil but AccountAPI.GetWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockGetWithContext.Lock()
+	mock.calls.GetWithContext = append(mock.calls.GetWithContext, callInfo)
+	mock.lockGetWithContext.Unlock()
+	return mock.GetWithContextFunc(ctx, input)
+}
+
+// GetWithContextCalls gets all the calls that were made to GetWithContext.
+func (mock *AccountAPIMock) GetWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}
+	mock.lockGetWithContext.RLock()
+	calls = mock.calls.GetWithContext
+	mock.lockGetWithContext.RUnlock()
+	return calls
+}
+
+// Hotspots calls HotspotsFunc.
+func (mock *AccountAPIMock) Hotspots(input *helium.AccountInput) (*helium.Hotspots, error) {
+	if mock.HotspotsFunc == nil {
+		panic("AccountAPIMock.HotspotsFunc: method is nil but AccountAPI.Hotspots was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+	}{
+		Input: input,
+	}
+	mock.lockHotspots.Lock()
+	mock.calls.Hotspots = append(mock.calls.Hotspots, callInfo)
+	mock.lockHotspots.Unlock()
+	return mock.HotspotsFunc(input)
+}
+
+// HotspotsCalls gets all the calls that were made to Hotspots.
+func (mock *AccountAPIMock) HotspotsCalls() []struct {
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+	}
+	mock.lockHotspots.RLock()
+	calls = mock.calls.Hotspots
+	mock.lockHotspots.RUnlock()
+	return calls
+}
+
+// HotspotsWithContext calls HotspotsWithContextFunc.
+func (mock *AccountAPIMock) HotspotsWithContext(ctx context.Context, input *helium.AccountInput) (*helium.Hotspots, error) {
+	if mock.HotspotsWithContextFunc == nil {
+		panic("AccountAPIMock.HotspotsWithContextFunc: method is nil but AccountAPI.HotspotsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockHotspotsWithContext.Lock()
+	mock.calls.HotspotsWithContext = append(mock.calls.HotspotsWithContext, callInfo)
+	mock.lockHotspotsWithContext.Unlock()
+	return mock.HotspotsWithContextFunc(ctx, input)
+}
+
+// HotspotsWithContextCalls gets all the calls that were made to HotspotsWithContext.
+func (mock *AccountAPIMock) HotspotsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}
+	mock.lockHotspotsWithContext.RLock()
+	calls = mock.calls.HotspotsWithContext
+	mock.lockHotspotsWithContext.RUnlock()
+	return calls
+}
+
+// HotspotsAll calls HotspotsAllFunc.
+func (mock *AccountAPIMock) HotspotsAll(input *helium.AccountInput, opts ...helium.PagerOption) *helium.HotspotPager {
+	if mock.HotspotsAllFunc == nil {
+		panic("AccountAPIMock.HotspotsAllFunc: method is nil but AccountAPI.HotspotsAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockHotspotsAll.Lock()
+	mock.calls.HotspotsAll = append(mock.calls.HotspotsAll, callInfo)
+	mock.lockHotspotsAll.Unlock()
+	return mock.HotspotsAllFunc(input, opts...)
+}
+
+// HotspotsAllCalls gets all the calls that were made to HotspotsAll.
+func (mock *AccountAPIMock) HotspotsAllCalls() []struct {
+	Input *helium.AccountInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockHotspotsAll.RLock()
+	calls = mock.calls.HotspotsAll
+	mock.lockHotspotsAll.RUnlock()
+	return calls
+}
+
+// Ouis calls OuisFunc.
+func (mock *AccountAPIMock) Ouis(input *helium.AccountInput) (*helium.Ouis, error) {
+	if mock.OuisFunc == nil {
+		panic("AccountAPIMock.OuisFunc: method is nil but AccountAPI.Ouis was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+	}{
+		Input: input,
+	}
+	mock.lockOuis.Lock()
+	mock.calls.Ouis = append(mock.calls.Ouis, callInfo)
+	mock.lockOuis.Unlock()
+	return mock.OuisFunc(input)
+}
+
+// OuisCalls gets all the calls that were made to Ouis.
+func (mock *AccountAPIMock) OuisCalls() []struct {
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+	}
+	mock.lockOuis.RLock()
+	calls = mock.calls.Ouis
+	mock.lockOuis.RUnlock()
+	return calls
+}
+
+// OuisWithContext calls OuisWithContextFunc.
+func (mock *AccountAPIMock) OuisWithContext(ctx context.Context, input *helium.AccountInput) (*helium.Ouis, error) {
+	if mock.OuisWithContextFunc == nil {
+		panic("AccountAPIMock.OuisWithContextFunc: method is nil but AccountAPI.OuisWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockOuisWithContext.Lock()
+	mock.calls.OuisWithContext = append(mock.calls.OuisWithContext, callInfo)
+	mock.lockOuisWithContext.Unlock()
+	return mock.OuisWithContextFunc(ctx, input)
+}
+
+// OuisWithContextCalls gets all the calls that were made to OuisWithContext.
+func (mock *AccountAPIMock) OuisWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}
+	mock.lockOuisWithContext.RLock()
+	calls = mock.calls.OuisWithContext
+	mock.lockOuisWithContext.RUnlock()
+	return calls
+}
+
+// OuisAll calls OuisAllFunc.
+func (mock *AccountAPIMock) OuisAll(input *helium.AccountInput, opts ...helium.PagerOption) *helium.OuiPager {
+	if mock.OuisAllFunc == nil {
+		panic("AccountAPIMock.OuisAllFunc: method is nil but AccountAPI.OuisAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockOuisAll.Lock()
+	mock.calls.OuisAll = append(mock.calls.OuisAll, callInfo)
+	mock.lockOuisAll.Unlock()
+	return mock.OuisAllFunc(input, opts...)
+}
+
+// OuisAllCalls gets all the calls that were made to OuisAll.
+func (mock *AccountAPIMock) OuisAllCalls() []struct {
+	Input *helium.AccountInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockOuisAll.RLock()
+	calls = mock.calls.OuisAll
+	mock.lockOuisAll.RUnlock()
+	return calls
+}
+
+// Activity calls ActivityFunc.
+func (mock *AccountAPIMock) Activity(input *helium.AccountActivityInput) (*helium.Activity, error) {
+	if mock.ActivityFunc == nil {
+		panic("AccountAPIMock.ActivityFunc: method is nil but AccountAPI.Activity was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountActivityInput
+	}{
+		Input: input,
+	}
+	mock.lockActivity.Lock()
+	mock.calls.Activity = append(mock.calls.Activity, callInfo)
+	mock.lockActivity.Unlock()
+	return mock.ActivityFunc(input)
+}
+
+// ActivityCalls gets all the calls that were made to Activity.
+func (mock *AccountAPIMock) ActivityCalls() []struct {
+	Input *helium.AccountActivityInput
+} {
+	var calls []struct {
+		Input *helium.AccountActivityInput
+	}
+	mock.lockActivity.RLock()
+	calls = mock.calls.Activity
+	mock.lockActivity.RUnlock()
+	return calls
+}
+
+// ActivityWithContext calls ActivityWithContextFunc.
+func (mock *AccountAPIMock) ActivityWithContext(ctx context.Context, input *helium.AccountActivityInput) (*helium.Activity, error) {
+	if mock.ActivityWithContextFunc == nil {
+		panic("AccountAPIMock.ActivityWithContextFunc: method is nil but AccountAPI.ActivityWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountActivityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockActivityWithContext.Lock()
+	mock.calls.ActivityWithContext = append(mock.calls.ActivityWithContext, callInfo)
+	mock.lockActivityWithContext.Unlock()
+	return mock.ActivityWithContextFunc(ctx, input)
+}
+
+// ActivityWithContextCalls gets all the calls that were made to ActivityWithContext.
+func (mock *AccountAPIMock) ActivityWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountActivityInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountActivityInput
+	}
+	mock.lockActivityWithContext.RLock()
+	calls = mock.calls.ActivityWithContext
+	mock.lockActivityWithContext.RUnlock()
+	return calls
+}
+
+// ActivityAll calls ActivityAllFunc.
+func (mock *AccountAPIMock) ActivityAll(input *helium.AccountActivityInput, opts ...helium.PagerOption) *helium.ActivityPager {
+	if mock.ActivityAllFunc == nil {
+		panic("AccountAPIMock.ActivityAllFunc: method is nil but AccountAPI.ActivityAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountActivityInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockActivityAll.Lock()
+	mock.calls.ActivityAll = append(mock.calls.ActivityAll, callInfo)
+	mock.lockActivityAll.Unlock()
+	return mock.ActivityAllFunc(input, opts...)
+}
+
+// ActivityAllCalls gets all the calls that were made to ActivityAll.
+func (mock *AccountAPIMock) ActivityAllCalls() []struct {
+	Input *helium.AccountActivityInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.AccountActivityInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockActivityAll.RLock()
+	calls = mock.calls.ActivityAll
+	mock.lockActivityAll.RUnlock()
+	return calls
+}
+
+// Roles calls RolesFunc.
+func (mock *AccountAPIMock) Roles(input *helium.AccountActivityInput) (*helium.Roles, error) {
+	if mock.RolesFunc == nil {
+		panic("AccountAPIMock.RolesFunc: method is nil but AccountAPI.Roles was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountActivityInput
+	}{
+		Input: input,
+	}
+	mock.lockRoles.Lock()
+	mock.calls.Roles = append(mock.calls.Roles, callInfo)
+	mock.lockRoles.Unlock()
+	return mock.RolesFunc(input)
+}
+
+// RolesCalls gets all the calls that were made to Roles.
+func (mock *AccountAPIMock) RolesCalls() []struct {
+	Input *helium.AccountActivityInput
+} {
+	var calls []struct {
+		Input *helium.AccountActivityInput
+	}
+	mock.lockRoles.RLock()
+	calls = mock.calls.Roles
+	mock.lockRoles.RUnlock()
+	return calls
+}
+
+// RolesWithContext calls RolesWithContextFunc.
+func (mock *AccountAPIMock) RolesWithContext(ctx context.Context, input *helium.AccountActivityInput) (*helium.Roles, error) {
+	if mock.RolesWithContextFunc == nil {
+		panic("AccountAPIMock.RolesWithContextFunc: method is nil but AccountAPI.RolesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountActivityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRolesWithContext.Lock()
+	mock.calls.RolesWithContext = append(mock.calls.RolesWithContext, callInfo)
+	mock.lockRolesWithContext.Unlock()
+	return mock.RolesWithContextFunc(ctx, input)
+}
+
+// RolesWithContextCalls gets all the calls that were made to RolesWithContext.
+func (mock *AccountAPIMock) RolesWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountActivityInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountActivityInput
+	}
+	mock.lockRolesWithContext.RLock()
+	calls = mock.calls.RolesWithContext
+	mock.lockRolesWithContext.RUnlock()
+	return calls
+}
+
+// RolesAll calls RolesAllFunc.
+func (mock *AccountAPIMock) RolesAll(input *helium.AccountActivityInput, opts ...helium.PagerOption) *helium.RolePager {
+	if mock.RolesAllFunc == nil {
+		panic("AccountAPIMock.RolesAllFunc: method is nil but AccountAPI.RolesAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountActivityInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockRolesAll.Lock()
+	mock.calls.RolesAll = append(mock.calls.RolesAll, callInfo)
+	mock.lockRolesAll.Unlock()
+	return mock.RolesAllFunc(input, opts...)
+}
+
+// RolesAllCalls gets all the calls that were made to RolesAll.
+func (mock *AccountAPIMock) RolesAllCalls() []struct {
+	Input *helium.AccountActivityInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.AccountActivityInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockRolesAll.RLock()
+	calls = mock.calls.RolesAll
+	mock.lockRolesAll.RUnlock()
+	return calls
+}
+
+// ActivityCount calls ActivityCountFunc.
+func (mock *AccountAPIMock) ActivityCount(input *helium.AccountInput) (*helium.ActivityCount, error) {
+	if mock.ActivityCountFunc == nil {
+		panic("AccountAPIMock.ActivityCountFunc: method is nil but AccountAPI.ActivityCount was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+	}{
+		Input: input,
+	}
+	mock.lockActivityCount.Lock()
+	mock.calls.ActivityCount = append(mock.calls.ActivityCount, callInfo)
+	mock.lockActivityCount.Unlock()
+	return mock.ActivityCountFunc(input)
+}
+
+// ActivityCountCalls gets all the calls that were made to ActivityCount.
+func (mock *AccountAPIMock) ActivityCountCalls() []struct {
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+	}
+	mock.lockActivityCount.RLock()
+	calls = mock.calls.ActivityCount
+	mock.lockActivityCount.RUnlock()
+	return calls
+}
+
+// ActivityCountWithContext calls ActivityCountWithContextFunc.
+func (mock *AccountAPIMock) ActivityCountWithContext(ctx context.Context, input *helium.AccountInput) (*helium.ActivityCount, error) {
+	if mock.ActivityCountWithContextFunc == nil {
+		panic("AccountAPIMock.ActivityCountWithContextFunc: method is nil but AccountAPI.ActivityCountWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockActivityCountWithContext.Lock()
+	mock.calls.ActivityCountWithContext = append(mock.calls.ActivityCountWithContext, callInfo)
+	mock.lockActivityCountWithContext.Unlock()
+	return mock.ActivityCountWithContextFunc(ctx, input)
+}
+
+// ActivityCountWithContextCalls gets all the calls that were made to ActivityCountWithContext.
+func (mock *AccountAPIMock) ActivityCountWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}
+	mock.lockActivityCountWithContext.RLock()
+	calls = mock.calls.ActivityCountWithContext
+	mock.lockActivityCountWithContext.RUnlock()
+	return calls
+}
+
+// Elections calls ElectionsFunc.
+func (mock *AccountAPIMock) Elections(input *helium.AccountInput) (*helium.Elections, error) {
+	if mock.ElectionsFunc == nil {
+		panic("AccountAPIMock.ElectionsFunc: method is nil but AccountAPI.Elections was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+	}{
+		Input: input,
+	}
+	mock.lockElections.Lock()
+	mock.calls.Elections = append(mock.calls.Elections, callInfo)
+	mock.lockElections.Unlock()
+	return mock.ElectionsFunc(input)
+}
+
+// ElectionsCalls gets all the calls that were made to Elections.
+func (mock *AccountAPIMock) ElectionsCalls() []struct {
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+	}
+	mock.lockElections.RLock()
+	calls = mock.calls.Elections
+	mock.lockElections.RUnlock()
+	return calls
+}
+
+// ElectionsWithContext calls ElectionsWithContextFunc.
+func (mock *AccountAPIMock) ElectionsWithContext(ctx context.Context, input *helium.AccountInput) (*helium.Elections, error) {
+	if mock.ElectionsWithContextFunc == nil {
+		panic("AccountAPIMock.ElectionsWithContextFunc: method is nil but AccountAPI.ElectionsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockElectionsWithContext.Lock()
+	mock.calls.ElectionsWithContext = append(mock.calls.ElectionsWithContext, callInfo)
+	mock.lockElectionsWithContext.Unlock()
+	return mock.ElectionsWithContextFunc(ctx, input)
+}
+
+// ElectionsWithContextCalls gets all the calls that were made to ElectionsWithContext.
+func (mock *AccountAPIMock) ElectionsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}
+	mock.lockElectionsWithContext.RLock()
+	calls = mock.calls.ElectionsWithContext
+	mock.lockElectionsWithContext.RUnlock()
+	return calls
+}
+
+// ElectionsAll calls ElectionsAllFunc.
+func (mock *AccountAPIMock) ElectionsAll(input *helium.AccountInput, opts ...helium.PagerOption) *helium.ElectionPager {
+	if mock.ElectionsAllFunc == nil {
+		panic("AccountAPIMock.ElectionsAllFunc: method is nil but AccountAPI.ElectionsAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockElectionsAll.Lock()
+	mock.calls.ElectionsAll = append(mock.calls.ElectionsAll, callInfo)
+	mock.lockElectionsAll.Unlock()
+	return mock.ElectionsAllFunc(input, opts...)
+}
+
+// ElectionsAllCalls gets all the calls that were made to ElectionsAll.
+func (mock *AccountAPIMock) ElectionsAllCalls() []struct {
+	Input *helium.AccountInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockElectionsAll.RLock()
+	calls = mock.calls.ElectionsAll
+	mock.lockElectionsAll.RUnlock()
+	return calls
+}
+
+// Challenges calls ChallengesFunc.
+func (mock *AccountAPIMock) Challenges(input *helium.AccountInput) (*helium.Challenges, error) {
+	if mock.ChallengesFunc == nil {
+		panic("AccountAPIMock.ChallengesFunc: method is nil but AccountAPI.Challenges was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+	}{
+		Input: input,
+	}
+	mock.lockChallenges.Lock()
+	mock.calls.Challenges = append(mock.calls.Challenges, callInfo)
+	mock.lockChallenges.Unlock()
+	return mock.ChallengesFunc(input)
+}
+
+// ChallengesCalls gets all the calls that were made to Challenges.
+func (mock *AccountAPIMock) ChallengesCalls() []struct {
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+	}
+	mock.lockChallenges.RLock()
+	calls = mock.calls.Challenges
+	mock.lockChallenges.RUnlock()
+	return calls
+}
+
+// ChallengesWithContext calls ChallengesWithContextFunc.
+func (mock *AccountAPIMock) ChallengesWithContext(ctx context.Context, input *helium.AccountInput) (*helium.Challenges, error) {
+	if mock.ChallengesWithContextFunc == nil {
+		panic("AccountAPIMock.ChallengesWithContextFunc: method is nil but AccountAPI.ChallengesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockChallengesWithContext.Lock()
+	mock.calls.ChallengesWithContext = append(mock.calls.ChallengesWithContext, callInfo)
+	mock.lockChallengesWithContext.Unlock()
+	return mock.ChallengesWithContextFunc(ctx, input)
+}
+
+// ChallengesWithContextCalls gets all the calls that were made to ChallengesWithContext.
+func (mock *AccountAPIMock) ChallengesWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}
+	mock.lockChallengesWithContext.RLock()
+	calls = mock.calls.ChallengesWithContext
+	mock.lockChallengesWithContext.RUnlock()
+	return calls
+}
+
+// ChallengesAll calls ChallengesAllFunc.
+func (mock *AccountAPIMock) ChallengesAll(input *helium.AccountInput, opts ...helium.PagerOption) *helium.ChallengePager {
+	if mock.ChallengesAllFunc == nil {
+		panic("AccountAPIMock.ChallengesAllFunc: method is nil but AccountAPI.ChallengesAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockChallengesAll.Lock()
+	mock.calls.ChallengesAll = append(mock.calls.ChallengesAll, callInfo)
+	mock.lockChallengesAll.Unlock()
+	return mock.ChallengesAllFunc(input, opts...)
+}
+
+// ChallengesAllCalls gets all the calls that were made to ChallengesAll.
+func (mock *AccountAPIMock) ChallengesAllCalls() []struct {
+	Input *helium.AccountInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockChallengesAll.RLock()
+	calls = mock.calls.ChallengesAll
+	mock.lockChallengesAll.RUnlock()
+	return calls
+}
+
+// PendingTransactions calls PendingTransactionsFunc.
+func (mock *AccountAPIMock) PendingTransactions(input *helium.AccountInput) (*helium.PendingTransactions, error) {
+	if mock.PendingTransactionsFunc == nil {
+		panic("AccountAPIMock.PendingTransactionsFunc: method is nil but AccountAPI.PendingTransactions was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+	}{
+		Input: input,
+	}
+	mock.lockPendingTransactions.Lock()
+	mock.calls.PendingTransactions = append(mock.calls.PendingTransactions, callInfo)
+	mock.lockPendingTransactions.Unlock()
+	return mock.PendingTransactionsFunc(input)
+}
+
+// PendingTransactionsCalls gets all the calls that were made to PendingTransactions.
+func (mock *AccountAPIMock) PendingTransactionsCalls() []struct {
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+	}
+	mock.lockPendingTransactions.RLock()
+	calls = mock.calls.PendingTransactions
+	mock.lockPendingTransactions.RUnlock()
+	return calls
+}
+
+// PendingTransactionsWithContext calls PendingTransactionsWithContextFunc.
+func (mock *AccountAPIMock) PendingTransactionsWithContext(ctx context.Context, input *helium.AccountInput) (*helium.PendingTransactions, error) {
+	if mock.PendingTransactionsWithContextFunc == nil {
+		panic("AccountAPIMock.PendingTransactionsWithContextFunc: method is nil but AccountAPI.PendingTransactionsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockPendingTransactionsWithContext.Lock()
+	mock.calls.PendingTransactionsWithContext = append(mock.calls.PendingTransactionsWithContext, callInfo)
+	mock.lockPendingTransactionsWithContext.Unlock()
+	return mock.PendingTransactionsWithContextFunc(ctx, input)
+}
+
+// PendingTransactionsWithContextCalls gets all the calls that were made to PendingTransactionsWithContext.
+func (mock *AccountAPIMock) PendingTransactionsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}
+	mock.lockPendingTransactionsWithContext.RLock()
+	calls = mock.calls.PendingTransactionsWithContext
+	mock.lockPendingTransactionsWithContext.RUnlock()
+	return calls
+}
+
+// PendingTransactionsAll calls PendingTransactionsAllFunc.
+func (mock *AccountAPIMock) PendingTransactionsAll(input *helium.AccountInput, opts ...helium.PagerOption) *helium.PendingTransactionPager {
+	if mock.PendingTransactionsAllFunc == nil {
+		panic("AccountAPIMock.PendingTransactionsAllFunc: method is nil but AccountAPI.PendingTransactionsAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockPendingTransactionsAll.Lock()
+	mock.calls.PendingTransactionsAll = append(mock.calls.PendingTransactionsAll, callInfo)
+	mock.lockPendingTransactionsAll.Unlock()
+	return mock.PendingTransactionsAllFunc(input, opts...)
+}
+
+// PendingTransactionsAllCalls gets all the calls that were made to PendingTransactionsAll.
+func (mock *AccountAPIMock) PendingTransactionsAllCalls() []struct {
+	Input *helium.AccountInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockPendingTransactionsAll.RLock()
+	calls = mock.calls.PendingTransactionsAll
+	mock.lockPendingTransactionsAll.RUnlock()
+	return calls
+}
+
+// Rewards calls RewardsFunc.
+func (mock *AccountAPIMock) Rewards(input *helium.AccountRewardsInput) (*helium.Rewards, error) {
+	if mock.RewardsFunc == nil {
+		panic("AccountAPIMock.RewardsFunc: method is nil but AccountAPI.Rewards was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountRewardsInput
+	}{
+		Input: input,
+	}
+	mock.lockRewards.Lock()
+	mock.calls.Rewards = append(mock.calls.Rewards, callInfo)
+	mock.lockRewards.Unlock()
+	return mock.RewardsFunc(input)
+}
+
+// RewardsCalls gets all the calls that were made to Rewards.
+func (mock *AccountAPIMock) RewardsCalls() []struct {
+	Input *helium.AccountRewardsInput
+} {
+	var calls []struct {
+		Input *helium.AccountRewardsInput
+	}
+	mock.lockRewards.RLock()
+	calls = mock.calls.Rewards
+	mock.lockRewards.RUnlock()
+	return calls
+}
+
+// RewardsWithContext calls RewardsWithContextFunc.
+func (mock *AccountAPIMock) RewardsWithContext(ctx context.Context, input *helium.AccountRewardsInput) (*helium.Rewards, error) {
+	if mock.RewardsWithContextFunc == nil {
+		panic("AccountAPIMock.RewardsWithContextFunc: method is nil but AccountAPI.RewardsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountRewardsInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRewardsWithContext.Lock()
+	mock.calls.RewardsWithContext = append(mock.calls.RewardsWithContext, callInfo)
+	mock.lockRewardsWithContext.Unlock()
+	return mock.RewardsWithContextFunc(ctx, input)
+}
+
+// RewardsWithContextCalls gets all the calls that were made to RewardsWithContext.
+func (mock *AccountAPIMock) RewardsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountRewardsInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountRewardsInput
+	}
+	mock.lockRewardsWithContext.RLock()
+	calls = mock.calls.RewardsWithContext
+	mock.lockRewardsWithContext.RUnlock()
+	return calls
+}
+
+// RewardsAll calls RewardsAllFunc.
+func (mock *AccountAPIMock) RewardsAll(input *helium.AccountRewardsInput, opts ...helium.PagerOption) *helium.RewardPager {
+	if mock.RewardsAllFunc == nil {
+		panic("AccountAPIMock.RewardsAllFunc: method is nil but AccountAPI.RewardsAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountRewardsInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockRewardsAll.Lock()
+	mock.calls.RewardsAll = append(mock.calls.RewardsAll, callInfo)
+	mock.lockRewardsAll.Unlock()
+	return mock.RewardsAllFunc(input, opts...)
+}
+
+// RewardsAllCalls gets all the calls that were made to RewardsAll.
+func (mock *AccountAPIMock) RewardsAllCalls() []struct {
+	Input *helium.AccountRewardsInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.AccountRewardsInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockRewardsAll.RLock()
+	calls = mock.calls.RewardsAll
+	mock.lockRewardsAll.RUnlock()
+	return calls
+}
+
+// RewardSum calls RewardSumFunc.
+func (mock *AccountAPIMock) RewardSum(input *helium.AccountRewardsInput) (*helium.RewardSum, error) {
+	if mock.RewardSumFunc == nil {
+		panic("AccountAPIMock.RewardSumFunc: method is nil but AccountAPI.RewardSum was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountRewardsInput
+	}{
+		Input: input,
+	}
+	mock.lockRewardSum.Lock()
+	mock.calls.RewardSum = append(mock.calls.RewardSum, callInfo)
+	mock.lockRewardSum.Unlock()
+	return mock.RewardSumFunc(input)
+}
+
+// RewardSumCalls gets all the calls that were made to RewardSum.
+func (mock *AccountAPIMock) RewardSumCalls() []struct {
+	Input *helium.AccountRewardsInput
+} {
+	var calls []struct {
+		Input *helium.AccountRewardsInput
+	}
+	mock.lockRewardSum.RLock()
+	calls = mock.calls.RewardSum
+	mock.lockRewardSum.RUnlock()
+	return calls
+}
+
+// RewardSumWithContext calls RewardSumWithContextFunc.
+func (mock *AccountAPIMock) RewardSumWithContext(ctx context.Context, input *helium.AccountRewardsInput) (*helium.RewardSum, error) {
+	if mock.RewardSumWithContextFunc == nil {
+		panic("AccountAPIMock.RewardSumWithContextFunc: method is nil but AccountAPI.RewardSumWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountRewardsInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRewardSumWithContext.Lock()
+	mock.calls.RewardSumWithContext = append(mock.calls.RewardSumWithContext, callInfo)
+	mock.lockRewardSumWithContext.Unlock()
+	return mock.RewardSumWithContextFunc(ctx, input)
+}
+
+// RewardSumWithContextCalls gets all the calls that were made to RewardSumWithContext.
+func (mock *AccountAPIMock) RewardSumWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountRewardsInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountRewardsInput
+	}
+	mock.lockRewardSumWithContext.RLock()
+	calls = mock.calls.RewardSumWithContext
+	mock.lockRewardSumWithContext.RUnlock()
+	return calls
+}
+
+// RewardSumBuckets calls RewardSumBucketsFunc.
+func (mock *AccountAPIMock) RewardSumBuckets(input *helium.AccountRewardsInput, bucket helium.Bucket) (*helium.RewardBuckets, error) {
+	if mock.RewardSumBucketsFunc == nil {
+		panic("AccountAPIMock.RewardSumBucketsFunc: method is nil but AccountAPI.RewardSumBuckets was just called")
+	}
+	callInfo := struct {
+		Input  *helium.AccountRewardsInput
+		Bucket helium.Bucket
+	}{
+		Input:  input,
+		Bucket: bucket,
+	}
+	mock.lockRewardSumBuckets.Lock()
+	mock.calls.RewardSumBuckets = append(mock.calls.RewardSumBuckets, callInfo)
+	mock.lockRewardSumBuckets.Unlock()
+	return mock.RewardSumBucketsFunc(input, bucket)
+}
+
+// RewardSumBucketsCalls gets all the calls that were made to RewardSumBuckets.
+func (mock *AccountAPIMock) RewardSumBucketsCalls() []struct {
+	Input  *helium.AccountRewardsInput
+	Bucket helium.Bucket
+} {
+	var calls []struct {
+		Input  *helium.AccountRewardsInput
+		Bucket helium.Bucket
+	}
+	mock.lockRewardSumBuckets.RLock()
+	calls = mock.calls.RewardSumBuckets
+	mock.lockRewardSumBuckets.RUnlock()
+	return calls
+}
+
+// RewardSumBucketsWithContext calls RewardSumBucketsWithContextFunc.
+func (mock *AccountAPIMock) RewardSumBucketsWithContext(ctx context.Context, input *helium.AccountRewardsInput, bucket helium.Bucket) (*helium.RewardBuckets, error) {
+	if mock.RewardSumBucketsWithContextFunc == nil {
+		panic("AccountAPIMock.RewardSumBucketsWithContextFunc: method is nil but AccountAPI.RewardSumBucketsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Input  *helium.AccountRewardsInput
+		Bucket helium.Bucket
+	}{
+		Ctx:    ctx,
+		Input:  input,
+		Bucket: bucket,
+	}
+	mock.lockRewardSumBucketsWithContext.Lock()
+	mock.calls.RewardSumBucketsWithContext = append(mock.calls.RewardSumBucketsWithContext, callInfo)
+	mock.lockRewardSumBucketsWithContext.Unlock()
+	return mock.RewardSumBucketsWithContextFunc(ctx, input, bucket)
+}
+
+// RewardSumBucketsWithContextCalls gets all the calls that were made to RewardSumBucketsWithContext.
+func (mock *AccountAPIMock) RewardSumBucketsWithContextCalls() []struct {
+	Ctx    context.Context
+	Input  *helium.AccountRewardsInput
+	Bucket helium.Bucket
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Input  *helium.AccountRewardsInput
+		Bucket helium.Bucket
+	}
+	mock.lockRewardSumBucketsWithContext.RLock()
+	calls = mock.calls.RewardSumBucketsWithContext
+	mock.lockRewardSumBucketsWithContext.RUnlock()
+	return calls
+}
+
+// Stats calls StatsFunc.
+func (mock *AccountAPIMock) Stats(input *helium.AccountInput) (*helium.AccountStats, error) {
+	if mock.StatsFunc == nil {
+		panic("AccountAPIMock.StatsFunc: method is nil but AccountAPI.Stats was just called")
+	}
+	callInfo := struct {
+		Input *helium.AccountInput
+	}{
+		Input: input,
+	}
+	mock.lockStats.Lock()
+	mock.calls.Stats = append(mock.calls.Stats, callInfo)
+	mock.lockStats.Unlock()
+	return mock.StatsFunc(input)
+}
+
+// StatsCalls gets all the calls that were made to Stats.
+func (mock *AccountAPIMock) StatsCalls() []struct {
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Input *helium.AccountInput
+	}
+	mock.lockStats.RLock()
+	calls = mock.calls.Stats
+	mock.lockStats.RUnlock()
+	return calls
+}
+
+// StatsWithContext calls StatsWithContextFunc.
+func (mock *AccountAPIMock) StatsWithContext(ctx context.Context, input *helium.AccountInput) (*helium.AccountStats, error) {
+	if mock.StatsWithContextFunc == nil {
+		panic("AccountAPIMock.StatsWithContextFunc: method is nil but AccountAPI.StatsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockStatsWithContext.Lock()
+	mock.calls.StatsWithContext = append(mock.calls.StatsWithContext, callInfo)
+	mock.lockStatsWithContext.Unlock()
+	return mock.StatsWithContextFunc(ctx, input)
+}
+
+// StatsWithContextCalls gets all the calls that were made to StatsWithContext.
+func (mock *AccountAPIMock) StatsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.AccountInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.AccountInput
+	}
+	mock.lockStatsWithContext.RLock()
+	calls = mock.calls.StatsWithContext
+	mock.lockStatsWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that HotspotAPIMock does implement helium.HotspotAPI.
+var _ helium.HotspotAPI = &HotspotAPIMock{}
+
+// HotspotAPIMock is a mock implementation of helium.HotspotAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type HotspotAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.HotspotListInput) (*helium.Hotspots, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.HotspotListInput) (*helium.Hotspots, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(opts ...helium.PagerOption) *helium.HotspotPager
+
+	// GetFunc mocks the Get method.
+	GetFunc func(input *helium.HotspotInput) (*helium.HotspotInfo, error)
+
+	// GetWithContextFunc mocks the GetWithContext method.
+	GetWithContextFunc func(ctx context.Context, input *helium.HotspotInput) (*helium.HotspotInfo, error)
+
+	// GetByNameFunc mocks the GetByName method.
+	GetByNameFunc func(input *helium.HotspotInput) (*helium.Hotspots, error)
+
+	// GetByNameWithContextFunc mocks the GetByNameWithContext method.
+	GetByNameWithContextFunc func(ctx context.Context, input *helium.HotspotInput) (*helium.Hotspots, error)
+
+	// SearchFunc mocks the Search method.
+	SearchFunc func(input *helium.HotspotSearchInput) (*helium.Hotspots, error)
+
+	// SearchWithContextFunc mocks the SearchWithContext method.
+	SearchWithContextFunc func(ctx context.Context, input *helium.HotspotSearchInput) (*helium.Hotspots, error)
+
+	// DistanceFunc mocks the Distance method.
+	DistanceFunc func(input *helium.HotspotDistanceInput) (*helium.Hotspots, error)
+
+	// DistanceWithContextFunc mocks the DistanceWithContext method.
+	DistanceWithContextFunc func(ctx context.Context, input *helium.HotspotDistanceInput) (*helium.Hotspots, error)
+
+	// DistanceAllFunc mocks the DistanceAll method.
+	DistanceAllFunc func(input *helium.HotspotDistanceInput, opts ...helium.PagerOption) *helium.HotspotPager
+
+	// BoxFunc mocks the Box method.
+	BoxFunc func(input *helium.HotspotBoxInput) (*helium.Hotspots, error)
+
+	// BoxWithContextFunc mocks the BoxWithContext method.
+	BoxWithContextFunc func(ctx context.Context, input *helium.HotspotBoxInput) (*helium.Hotspots, error)
+
+	// BoxAllFunc mocks the BoxAll method.
+	BoxAllFunc func(input *helium.HotspotBoxInput, opts ...helium.PagerOption) *helium.HotspotPager
+
+	// GetByHexFunc mocks the GetByHex method.
+	GetByHexFunc func(input *helium.HotspotHexInput) (*helium.HotspotInfo, error)
+
+	// GetByHexWithContextFunc mocks the GetByHexWithContext method.
+	GetByHexWithContextFunc func(ctx context.Context, input *helium.HotspotHexInput) (*helium.HotspotInfo, error)
+
+	// ActivityFunc mocks the Activity method.
+	ActivityFunc func(input *helium.HotspotActivityInput) (*helium.HotspotsActivity, error)
+
+	// ActivityWithContextFunc mocks the ActivityWithContext method.
+	ActivityWithContextFunc func(ctx context.Context, input *helium.HotspotActivityInput) (*helium.HotspotsActivity, error)
+
+	// ActivityAllFunc mocks the ActivityAll method.
+	ActivityAllFunc func(input *helium.HotspotActivityInput, opts ...helium.PagerOption) *helium.HotspotActivityPager
+
+	// RolesFunc mocks the Roles method.
+	RolesFunc func(input *helium.HotspotActivityInput) (*helium.Roles, error)
+
+	// RolesWithContextFunc mocks the RolesWithContext method.
+	RolesWithContextFunc func(ctx context.Context, input *helium.HotspotActivityInput) (*helium.Roles, error)
+
+	// RolesAllFunc mocks the RolesAll method.
+	RolesAllFunc func(input *helium.HotspotActivityInput, opts ...helium.PagerOption) *helium.RolePager
+
+	// ActivityCountFunc mocks the ActivityCount method.
+	ActivityCountFunc func(input *helium.HotspotInput) (*helium.HotspotActivityCount, error)
+
+	// ActivityCountWithContextFunc mocks the ActivityCountWithContext method.
+	ActivityCountWithContextFunc func(ctx context.Context, input *helium.HotspotInput) (*helium.HotspotActivityCount, error)
+
+	// ElectionsFunc mocks the Elections method.
+	ElectionsFunc func(input *helium.HotspotInput) (*helium.Elections, error)
+
+	// ElectionsWithContextFunc mocks the ElectionsWithContext method.
+	ElectionsWithContextFunc func(ctx context.Context, input *helium.HotspotInput) (*helium.Elections, error)
+
+	// ElectionsAllFunc mocks the ElectionsAll method.
+	ElectionsAllFunc func(input *helium.HotspotInput, opts ...helium.PagerOption) *helium.ElectionPager
+
+	// CurrentlyElectedFunc mocks the CurrentlyElected method.
+	CurrentlyElectedFunc func() (*helium.Elections, error)
+
+	// CurrentlyElectedWithContextFunc mocks the CurrentlyElectedWithContext method.
+	CurrentlyElectedWithContextFunc func(ctx context.Context) (*helium.Elections, error)
+
+	// ChallengesFunc mocks the Challenges method.
+	ChallengesFunc func(input *helium.HotspotInput) (*helium.Challenges, error)
+
+	// ChallengesWithContextFunc mocks the ChallengesWithContext method.
+	ChallengesWithContextFunc func(ctx context.Context, input *helium.HotspotInput) (*helium.Challenges, error)
+
+	// ChallengesAllFunc mocks the ChallengesAll method.
+	ChallengesAllFunc func(input *helium.HotspotInput, opts ...helium.PagerOption) *helium.ChallengePager
+
+	// RewardsFunc mocks the Rewards method.
+	RewardsFunc func(input *helium.HotspotRewardsInput) (*helium.Rewards, error)
+
+	// RewardsWithContextFunc mocks the RewardsWithContext method.
+	RewardsWithContextFunc func(ctx context.Context, input *helium.HotspotRewardsInput) (*helium.Rewards, error)
+
+	// RewardsAllFunc mocks the RewardsAll method.
+	RewardsAllFunc func(input *helium.HotspotRewardsInput, opts ...helium.PagerOption) *helium.RewardPager
+
+	// RewardSumFunc mocks the RewardSum method.
+	RewardSumFunc func(input *helium.HotspotRewardsInput) (*helium.RewardSum, error)
+
+	// RewardSumWithContextFunc mocks the RewardSumWithContext method.
+	RewardSumWithContextFunc func(ctx context.Context, input *helium.HotspotRewardsInput) (*helium.RewardSum, error)
+
+	// RewardSumBucketsFunc mocks the RewardSumBuckets method.
+	RewardSumBucketsFunc func(input *helium.HotspotRewardsInput, bucket helium.Bucket) (*helium.RewardBuckets, error)
+
+	// RewardSumBucketsWithContextFunc mocks the RewardSumBucketsWithContext method.
+	RewardSumBucketsWithContextFunc func(ctx context.Context, input *helium.HotspotRewardsInput, bucket helium.Bucket) (*helium.RewardBuckets, error)
+
+	// WitnessesFunc mocks the Witnesses method.
+	WitnessesFunc func(input *helium.HotspotInput) (*helium.Witnesses, error)
+
+	// WitnessesWithContextFunc mocks the WitnessesWithContext method.
+	WitnessesWithContextFunc func(ctx context.Context, input *helium.HotspotInput) (*helium.Witnesses, error)
+
+	// WitnessedFunc mocks the Witnessed method.
+	WitnessedFunc func(input *helium.HotspotInput) (*helium.Witnesses, error)
+
+	// WitnessedWithContextFunc mocks the WitnessedWithContext method.
+	WitnessedWithContextFunc func(ctx context.Context, input *helium.HotspotInput) (*helium.Witnesses, error)
+
+	calls struct {
+		List []struct {
+			Input *helium.HotspotListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotListInput
+		}
+		ListAll []struct {
+			Opts []helium.PagerOption
+		}
+		Get []struct {
+			Input *helium.HotspotInput
+		}
+		GetWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotInput
+		}
+		GetByName []struct {
+			Input *helium.HotspotInput
+		}
+		GetByNameWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotInput
+		}
+		Search []struct {
+			Input *helium.HotspotSearchInput
+		}
+		SearchWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotSearchInput
+		}
+		Distance []struct {
+			Input *helium.HotspotDistanceInput
+		}
+		DistanceWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotDistanceInput
+		}
+		DistanceAll []struct {
+			Input *helium.HotspotDistanceInput
+			Opts  []helium.PagerOption
+		}
+		Box []struct {
+			Input *helium.HotspotBoxInput
+		}
+		BoxWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotBoxInput
+		}
+		BoxAll []struct {
+			Input *helium.HotspotBoxInput
+			Opts  []helium.PagerOption
+		}
+		GetByHex []struct {
+			Input *helium.HotspotHexInput
+		}
+		GetByHexWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotHexInput
+		}
+		Activity []struct {
+			Input *helium.HotspotActivityInput
+		}
+		ActivityWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotActivityInput
+		}
+		ActivityAll []struct {
+			Input *helium.HotspotActivityInput
+			Opts  []helium.PagerOption
+		}
+		Roles []struct {
+			Input *helium.HotspotActivityInput
+		}
+		RolesWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotActivityInput
+		}
+		RolesAll []struct {
+			Input *helium.HotspotActivityInput
+			Opts  []helium.PagerOption
+		}
+		ActivityCount []struct {
+			Input *helium.HotspotInput
+		}
+		ActivityCountWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotInput
+		}
+		Elections []struct {
+			Input *helium.HotspotInput
+		}
+		ElectionsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotInput
+		}
+		ElectionsAll []struct {
+			Input *helium.HotspotInput
+			Opts  []helium.PagerOption
+		}
+		CurrentlyElected []struct {
+		}
+		CurrentlyElectedWithContext []struct {
+			Ctx context.Context
+		}
+		Challenges []struct {
+			Input *helium.HotspotInput
+		}
+		ChallengesWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotInput
+		}
+		ChallengesAll []struct {
+			Input *helium.HotspotInput
+			Opts  []helium.PagerOption
+		}
+		Rewards []struct {
+			Input *helium.HotspotRewardsInput
+		}
+		RewardsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotRewardsInput
+		}
+		RewardsAll []struct {
+			Input *helium.HotspotRewardsInput
+			Opts  []helium.PagerOption
+		}
+		RewardSum []struct {
+			Input *helium.HotspotRewardsInput
+		}
+		RewardSumWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotRewardsInput
+		}
+		RewardSumBuckets []struct {
+			Input  *helium.HotspotRewardsInput
+			Bucket helium.Bucket
+		}
+		RewardSumBucketsWithContext []struct {
+			Ctx    context.Context
+			Input  *helium.HotspotRewardsInput
+			Bucket helium.Bucket
+		}
+		Witnesses []struct {
+			Input *helium.HotspotInput
+		}
+		WitnessesWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotInput
+		}
+		Witnessed []struct {
+			Input *helium.HotspotInput
+		}
+		WitnessedWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotInput
+		}
+	}
+	lockList                        sync.RWMutex
+	lockListWithContext             sync.RWMutex
+	lockListAll                     sync.RWMutex
+	lockGet                         sync.RWMutex
+	lockGetWithContext              sync.RWMutex
+	lockGetByName                   sync.RWMutex
+	lockGetByNameWithContext        sync.RWMutex
+	lockSearch                      sync.RWMutex
+	lockSearchWithContext           sync.RWMutex
+	lockDistance                    sync.RWMutex
+	lockDistanceWithContext         sync.RWMutex
+	lockDistanceAll                 sync.RWMutex
+	lockBox                         sync.RWMutex
+	lockBoxWithContext              sync.RWMutex
+	lockBoxAll                      sync.RWMutex
+	lockGetByHex                    sync.RWMutex
+	lockGetByHexWithContext         sync.RWMutex
+	lockActivity                    sync.RWMutex
+	lockActivityWithContext         sync.RWMutex
+	lockActivityAll                 sync.RWMutex
+	lockRoles                       sync.RWMutex
+	lockRolesWithContext            sync.RWMutex
+	lockRolesAll                    sync.RWMutex
+	lockActivityCount               sync.RWMutex
+	lockActivityCountWithContext    sync.RWMutex
+	lockElections                   sync.RWMutex
+	lockElectionsWithContext        sync.RWMutex
+	lockElectionsAll                sync.RWMutex
+	lockCurrentlyElected            sync.RWMutex
+	lockCurrentlyElectedWithContext sync.RWMutex
+	lockChallenges                  sync.RWMutex
+	lockChallengesWithContext       sync.RWMutex
+	lockChallengesAll               sync.RWMutex
+	lockRewards                     sync.RWMutex
+	lockRewardsWithContext          sync.RWMutex
+	lockRewardsAll                  sync.RWMutex
+	lockRewardSum                   sync.RWMutex
+	lockRewardSumWithContext        sync.RWMutex
+	lockRewardSumBuckets            sync.RWMutex
+	lockRewardSumBucketsWithContext sync.RWMutex
+	lockWitnesses                   sync.RWMutex
+	lockWitnessesWithContext        sync.RWMutex
+	lockWitnessed                   sync.RWMutex
+	lockWitnessedWithContext        sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *HotspotAPIMock) List(input *helium.HotspotListInput) (*helium.Hotspots, error) {
+	if mock.ListFunc == nil {
+		panic("HotspotAPIMock.ListFunc: method is nil but HotspotAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *HotspotAPIMock) ListCalls() []struct {
+	Input *helium.HotspotListInput
+} {
+	var calls []struct {
+		Input *helium.HotspotListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *HotspotAPIMock) ListWithContext(ctx context.Context, input *helium.HotspotListInput) (*helium.Hotspots, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("HotspotAPIMock.ListWithContextFunc: method is nil but HotspotAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *HotspotAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *HotspotAPIMock) ListAll(opts ...helium.PagerOption) *helium.HotspotPager {
+	if mock.ListAllFunc == nil {
+		panic("HotspotAPIMock.ListAllFunc: method is nil but HotspotAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Opts []helium.PagerOption
+	}{
+		Opts: opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *HotspotAPIMock) ListAllCalls() []struct {
+	Opts []helium.PagerOption
+} {
+	var calls []struct {
+		Opts []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *HotspotAPIMock) Get(input *helium.HotspotInput) (*helium.HotspotInfo, error) {
+	if mock.GetFunc == nil {
+		panic("HotspotAPIMock.GetFunc: method is nil but HotspotAPI.Get was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotInput
+	}{
+		Input: input,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(input)
+}
+
+// GetCalls gets all the calls that were made to Get.
+func (mock *HotspotAPIMock) GetCalls() []struct {
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Input *helium.HotspotInput
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (mock *HotspotAPIMock) GetWithContext(ctx context.Context, input *helium.HotspotInput) (*helium.HotspotInfo, error) {
+	if mock.GetWithContextFunc == nil {
+		panic("HotspotAPIMock.GetWithContextFunc: method is nil but HotspotAPI.GetWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockGetWithContext.Lock()
+	mock.calls.GetWithContext = append(mock.calls.GetWithContext, callInfo)
+	mock.lockGetWithContext.Unlock()
+	return mock.GetWithContextFunc(ctx, input)
+}
+
+// GetWithContextCalls gets all the calls that were made to GetWithContext.
+func (mock *HotspotAPIMock) GetWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}
+	mock.lockGetWithContext.RLock()
+	calls = mock.calls.GetWithContext
+	mock.lockGetWithContext.RUnlock()
+	return calls
+}
+
+// GetByName calls GetByNameFunc.
+func (mock *HotspotAPIMock) GetByName(input *helium.HotspotInput) (*helium.Hotspots, error) {
+	if mock.GetByNameFunc == nil {
+		panic("HotspotAPIMock.GetByNameFunc: method is nil but HotspotAPI.GetByName was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotInput
+	}{
+		Input: input,
+	}
+	mock.lockGetByName.Lock()
+	mock.calls.GetByName = append(mock.calls.GetByName, callInfo)
+	mock.lockGetByName.Unlock()
+	return mock.GetByNameFunc(input)
+}
+
+// GetByNameCalls gets all the calls that were made to GetByName.
+func (mock *HotspotAPIMock) GetByNameCalls() []struct {
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Input *helium.HotspotInput
+	}
+	mock.lockGetByName.RLock()
+	calls = mock.calls.GetByName
+	mock.lockGetByName.RUnlock()
+	return calls
+}
+
+// GetByNameWithContext calls GetByNameWithContextFunc.
+func (mock *HotspotAPIMock) GetByNameWithContext(ctx context.Context, input *helium.HotspotInput) (*helium.Hotspots, error) {
+	if mock.GetByNameWithContextFunc == nil {
+		panic("HotspotAPIMock.GetByNameWithContextFunc: method is nil but HotspotAPI.GetByNameWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockGetByNameWithContext.Lock()
+	mock.calls.GetByNameWithContext = append(mock.calls.GetByNameWithContext, callInfo)
+	mock.lockGetByNameWithContext.Unlock()
+	return mock.GetByNameWithContextFunc(ctx, input)
+}
+
+// GetByNameWithContextCalls gets all the calls that were made to GetByNameWithContext.
+func (mock *HotspotAPIMock) GetByNameWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}
+	mock.lockGetByNameWithContext.RLock()
+	calls = mock.calls.GetByNameWithContext
+	mock.lockGetByNameWithContext.RUnlock()
+	return calls
+}
+
+// Search calls SearchFunc.
+func (mock *HotspotAPIMock) Search(input *helium.HotspotSearchInput) (*helium.Hotspots, error) {
+	if mock.SearchFunc == nil {
+		panic("HotspotAPIMock.SearchFunc: method is nil but HotspotAPI.Search was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotSearchInput
+	}{
+		Input: input,
+	}
+	mock.lockSearch.Lock()
+	mock.calls.Search = append(mock.calls.Search, callInfo)
+	mock.lockSearch.Unlock()
+	return mock.SearchFunc(input)
+}
+
+// SearchCalls gets all the calls that were made to Search.
+func (mock *HotspotAPIMock) SearchCalls() []struct {
+	Input *helium.HotspotSearchInput
+} {
+	var calls []struct {
+		Input *helium.HotspotSearchInput
+	}
+	mock.lockSearch.RLock()
+	calls = mock.calls.Search
+	mock.lockSearch.RUnlock()
+	return calls
+}
+
+// SearchWithContext calls SearchWithContextFunc.
+func (mock *HotspotAPIMock) SearchWithContext(ctx context.Context, input *helium.HotspotSearchInput) (*helium.Hotspots, error) {
+	if mock.SearchWithContextFunc == nil {
+		panic("HotspotAPIMock.SearchWithContextFunc: method is nil but HotspotAPI.SearchWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotSearchInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockSearchWithContext.Lock()
+	mock.calls.SearchWithContext = append(mock.calls.SearchWithContext, callInfo)
+	mock.lockSearchWithContext.Unlock()
+	return mock.SearchWithContextFunc(ctx, input)
+}
+
+// SearchWithContextCalls gets all the calls that were made to SearchWithContext.
+func (mock *HotspotAPIMock) SearchWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotSearchInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotSearchInput
+	}
+	mock.lockSearchWithContext.RLock()
+	calls = mock.calls.SearchWithContext
+	mock.lockSearchWithContext.RUnlock()
+	return calls
+}
+
+// Distance calls DistanceFunc.
+func (mock *HotspotAPIMock) Distance(input *helium.HotspotDistanceInput) (*helium.Hotspots, error) {
+	if mock.DistanceFunc == nil {
+		panic("HotspotAPIMock.DistanceFunc: method is nil but HotspotAPI.Distance was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotDistanceInput
+	}{
+		Input: input,
+	}
+	mock.lockDistance.Lock()
+	mock.calls.Distance = append(mock.calls.Distance, callInfo)
+	mock.lockDistance.Unlock()
+	return mock.DistanceFunc(input)
+}
+
+// DistanceCalls gets all the calls that were made to Distance.
+func (mock *HotspotAPIMock) DistanceCalls() []struct {
+	Input *helium.HotspotDistanceInput
+} {
+	var calls []struct {
+		Input *helium.HotspotDistanceInput
+	}
+	mock.lockDistance.RLock()
+	calls = mock.calls.Distance
+	mock.lockDistance.RUnlock()
+	return calls
+}
+
+// DistanceWithContext calls DistanceWithContextFunc.
+func (mock *HotspotAPIMock) DistanceWithContext(ctx context.Context, input *helium.HotspotDistanceInput) (*helium.Hotspots, error) {
+	if mock.DistanceWithContextFunc == nil {
+		panic("HotspotAPIMock.DistanceWithContextFunc: method is nil but HotspotAPI.DistanceWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotDistanceInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockDistanceWithContext.Lock()
+	mock.calls.DistanceWithContext = append(mock.calls.DistanceWithContext, callInfo)
+	mock.lockDistanceWithContext.Unlock()
+	return mock.DistanceWithContextFunc(ctx, input)
+}
+
+// DistanceWithContextCalls gets all the calls that were made to DistanceWithContext.
+func (mock *HotspotAPIMock) DistanceWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotDistanceInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotDistanceInput
+	}
+	mock.lockDistanceWithContext.RLock()
+	calls = mock.calls.DistanceWithContext
+	mock.lockDistanceWithContext.RUnlock()
+	return calls
+}
+
+// DistanceAll calls DistanceAllFunc.
+func (mock *HotspotAPIMock) DistanceAll(input *helium.HotspotDistanceInput, opts ...helium.PagerOption) *helium.HotspotPager {
+	if mock.DistanceAllFunc == nil {
+		panic("HotspotAPIMock.DistanceAllFunc: method is nil but HotspotAPI.DistanceAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotDistanceInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockDistanceAll.Lock()
+	mock.calls.DistanceAll = append(mock.calls.DistanceAll, callInfo)
+	mock.lockDistanceAll.Unlock()
+	return mock.DistanceAllFunc(input, opts...)
+}
+
+// DistanceAllCalls gets all the calls that were made to DistanceAll.
+func (mock *HotspotAPIMock) DistanceAllCalls() []struct {
+	Input *helium.HotspotDistanceInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.HotspotDistanceInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockDistanceAll.RLock()
+	calls = mock.calls.DistanceAll
+	mock.lockDistanceAll.RUnlock()
+	return calls
+}
+
+// Box calls BoxFunc.
+func (mock *HotspotAPIMock) Box(input *helium.HotspotBoxInput) (*helium.Hotspots, error) {
+	if mock.BoxFunc == nil {
+		panic("HotspotAPIMock.BoxFunc: method is nil but HotspotAPI.Box was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotBoxInput
+	}{
+		Input: input,
+	}
+	mock.lockBox.Lock()
+	mock.calls.Box = append(mock.calls.Box, callInfo)
+	mock.lockBox.Unlock()
+	return mock.BoxFunc(input)
+}
+
+// BoxCalls gets all the calls that were made to Box.
+func (mock *HotspotAPIMock) BoxCalls() []struct {
+	Input *helium.HotspotBoxInput
+} {
+	var calls []struct {
+		Input *helium.HotspotBoxInput
+	}
+	mock.lockBox.RLock()
+	calls = mock.calls.Box
+	mock.lockBox.RUnlock()
+	return calls
+}
+
+// BoxWithContext calls BoxWithContextFunc.
+func (mock *HotspotAPIMock) BoxWithContext(ctx context.Context, input *helium.HotspotBoxInput) (*helium.Hotspots, error) {
+	if mock.BoxWithContextFunc == nil {
+		panic("HotspotAPIMock.BoxWithContextFunc: method is nil but HotspotAPI.BoxWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotBoxInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockBoxWithContext.Lock()
+	mock.calls.BoxWithContext = append(mock.calls.BoxWithContext, callInfo)
+	mock.lockBoxWithContext.Unlock()
+	return mock.BoxWithContextFunc(ctx, input)
+}
+
+// BoxWithContextCalls gets all the calls that were made to BoxWithContext.
+func (mock *HotspotAPIMock) BoxWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotBoxInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotBoxInput
+	}
+	mock.lockBoxWithContext.RLock()
+	calls = mock.calls.BoxWithContext
+	mock.lockBoxWithContext.RUnlock()
+	return calls
+}
+
+// BoxAll calls BoxAllFunc.
+func (mock *HotspotAPIMock) BoxAll(input *helium.HotspotBoxInput, opts ...helium.PagerOption) *helium.HotspotPager {
+	if mock.BoxAllFunc == nil {
+		panic("HotspotAPIMock.BoxAllFunc: method is nil but HotspotAPI.BoxAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotBoxInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockBoxAll.Lock()
+	mock.calls.BoxAll = append(mock.calls.BoxAll, callInfo)
+	mock.lockBoxAll.Unlock()
+	return mock.BoxAllFunc(input, opts...)
+}
+
+// BoxAllCalls gets all the calls that were made to BoxAll.
+func (mock *HotspotAPIMock) BoxAllCalls() []struct {
+	Input *helium.HotspotBoxInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.HotspotBoxInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockBoxAll.RLock()
+	calls = mock.calls.BoxAll
+	mock.lockBoxAll.RUnlock()
+	return calls
+}
+
+// GetByHex calls GetByHexFunc.
+func (mock *HotspotAPIMock) GetByHex(input *helium.HotspotHexInput) (*helium.HotspotInfo, error) {
+	if mock.GetByHexFunc == nil {
+		panic("HotspotAPIMock.GetByHexFunc: method is nil but HotspotAPI.GetByHex was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotHexInput
+	}{
+		Input: input,
+	}
+	mock.lockGetByHex.Lock()
+	mock.calls.GetByHex = append(mock.calls.GetByHex, callInfo)
+	mock.lockGetByHex.Unlock()
+	return mock.GetByHexFunc(input)
+}
+
+// GetByHexCalls gets all the calls that were made to GetByHex.
+func (mock *HotspotAPIMock) GetByHexCalls() []struct {
+	Input *helium.HotspotHexInput
+} {
+	var calls []struct {
+		Input *helium.HotspotHexInput
+	}
+	mock.lockGetByHex.RLock()
+	calls = mock.calls.GetByHex
+	mock.lockGetByHex.RUnlock()
+	return calls
+}
+
+// GetByHexWithContext calls GetByHexWithContextFunc.
+func (mock *HotspotAPIMock) GetByHexWithContext(ctx context.Context, input *helium.HotspotHexInput) (*helium.HotspotInfo, error) {
+	if mock.GetByHexWithContextFunc == nil {
+		panic("HotspotAPIMock.GetByHexWithContextFunc: method is nil but HotspotAPI.GetByHexWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotHexInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockGetByHexWithContext.Lock()
+	mock.calls.GetByHexWithContext = append(mock.calls.GetByHexWithContext, callInfo)
+	mock.lockGetByHexWithContext.Unlock()
+	return mock.GetByHexWithContextFunc(ctx, input)
+}
+
+// GetByHexWithContextCalls gets all the calls that were made to GetByHexWithContext.
+func (mock *HotspotAPIMock) GetByHexWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotHexInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotHexInput
+	}
+	mock.lockGetByHexWithContext.RLock()
+	calls = mock.calls.GetByHexWithContext
+	mock.lockGetByHexWithContext.RUnlock()
+	return calls
+}
+
+// Activity calls ActivityFunc.
+func (mock *HotspotAPIMock) Activity(input *helium.HotspotActivityInput) (*helium.HotspotsActivity, error) {
+	if mock.ActivityFunc == nil {
+		panic("HotspotAPIMock.ActivityFunc: method is nil but HotspotAPI.Activity was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotActivityInput
+	}{
+		Input: input,
+	}
+	mock.lockActivity.Lock()
+	mock.calls.Activity = append(mock.calls.Activity, callInfo)
+	mock.lockActivity.Unlock()
+	return mock.ActivityFunc(input)
+}
+
+// ActivityCalls gets all the calls that were made to Activity.
+func (mock *HotspotAPIMock) ActivityCalls() []struct {
+	Input *helium.HotspotActivityInput
+} {
+	var calls []struct {
+		Input *helium.HotspotActivityInput
+	}
+	mock.lockActivity.RLock()
+	calls = mock.calls.Activity
+	mock.lockActivity.RUnlock()
+	return calls
+}
+
+// ActivityWithContext calls ActivityWithContextFunc.
+func (mock *HotspotAPIMock) ActivityWithContext(ctx context.Context, input *helium.HotspotActivityInput) (*helium.HotspotsActivity, error) {
+	if mock.ActivityWithContextFunc == nil {
+		panic("HotspotAPIMock.ActivityWithContextFunc: method is nil but HotspotAPI.ActivityWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotActivityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockActivityWithContext.Lock()
+	mock.calls.ActivityWithContext = append(mock.calls.ActivityWithContext, callInfo)
+	mock.lockActivityWithContext.Unlock()
+	return mock.ActivityWithContextFunc(ctx, input)
+}
+
+// ActivityWithContextCalls gets all the calls that were made to ActivityWithContext.
+func (mock *HotspotAPIMock) ActivityWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotActivityInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotActivityInput
+	}
+	mock.lockActivityWithContext.RLock()
+	calls = mock.calls.ActivityWithContext
+	mock.lockActivityWithContext.RUnlock()
+	return calls
+}
+
+// ActivityAll calls ActivityAllFunc.
+func (mock *HotspotAPIMock) ActivityAll(input *helium.HotspotActivityInput, opts ...helium.PagerOption) *helium.HotspotActivityPager {
+	if mock.ActivityAllFunc == nil {
+		panic("HotspotAPIMock.ActivityAllFunc: method is nil but HotspotAPI.ActivityAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotActivityInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockActivityAll.Lock()
+	mock.calls.ActivityAll = append(mock.calls.ActivityAll, callInfo)
+	mock.lockActivityAll.Unlock()
+	return mock.ActivityAllFunc(input, opts...)
+}
+
+// ActivityAllCalls gets all the calls that were made to ActivityAll.
+func (mock *HotspotAPIMock) ActivityAllCalls() []struct {
+	Input *helium.HotspotActivityInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.HotspotActivityInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockActivityAll.RLock()
+	calls = mock.calls.ActivityAll
+	mock.lockActivityAll.RUnlock()
+	return calls
+}
+
+// Roles calls RolesFunc.
+func (mock *HotspotAPIMock) Roles(input *helium.HotspotActivityInput) (*helium.Roles, error) {
+	if mock.RolesFunc == nil {
+		panic("HotspotAPIMock.RolesFunc: method is nil but HotspotAPI.Roles was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotActivityInput
+	}{
+		Input: input,
+	}
+	mock.lockRoles.Lock()
+	mock.calls.Roles = append(mock.calls.Roles, callInfo)
+	mock.lockRoles.Unlock()
+	return mock.RolesFunc(input)
+}
+
+// RolesCalls gets all the calls that were made to Roles.
+func (mock *HotspotAPIMock) RolesCalls() []struct {
+	Input *helium.HotspotActivityInput
+} {
+	var calls []struct {
+		Input *helium.HotspotActivityInput
+	}
+	mock.lockRoles.RLock()
+	calls = mock.calls.Roles
+	mock.lockRoles.RUnlock()
+	return calls
+}
+
+// RolesWithContext calls RolesWithContextFunc.
+func (mock *HotspotAPIMock) RolesWithContext(ctx context.Context, input *helium.HotspotActivityInput) (*helium.Roles, error) {
+	if mock.RolesWithContextFunc == nil {
+		panic("HotspotAPIMock.RolesWithContextFunc: method is nil but HotspotAPI.RolesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotActivityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRolesWithContext.Lock()
+	mock.calls.RolesWithContext = append(mock.calls.RolesWithContext, callInfo)
+	mock.lockRolesWithContext.Unlock()
+	return mock.RolesWithContextFunc(ctx, input)
+}
+
+// RolesWithContextCalls gets all the calls that were made to RolesWithContext.
+func (mock *HotspotAPIMock) RolesWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotActivityInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotActivityInput
+	}
+	mock.lockRolesWithContext.RLock()
+	calls = mock.calls.RolesWithContext
+	mock.lockRolesWithContext.RUnlock()
+	return calls
+}
+
+// RolesAll calls RolesAllFunc.
+func (mock *HotspotAPIMock) RolesAll(input *helium.HotspotActivityInput, opts ...helium.PagerOption) *helium.RolePager {
+	if mock.RolesAllFunc == nil {
+		panic("HotspotAPIMock.RolesAllFunc: method is nil but HotspotAPI.RolesAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotActivityInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockRolesAll.Lock()
+	mock.calls.RolesAll = append(mock.calls.RolesAll, callInfo)
+	mock.lockRolesAll.Unlock()
+	return mock.RolesAllFunc(input, opts...)
+}
+
+// RolesAllCalls gets all the calls that were made to RolesAll.
+func (mock *HotspotAPIMock) RolesAllCalls() []struct {
+	Input *helium.HotspotActivityInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.HotspotActivityInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockRolesAll.RLock()
+	calls = mock.calls.RolesAll
+	mock.lockRolesAll.RUnlock()
+	return calls
+}
+
+// ActivityCount calls ActivityCountFunc.
+func (mock *HotspotAPIMock) ActivityCount(input *helium.HotspotInput) (*helium.HotspotActivityCount, error) {
+	if mock.ActivityCountFunc == nil {
+		panic("HotspotAPIMock.ActivityCountFunc: method is nil but HotspotAPI.ActivityCount was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotInput
+	}{
+		Input: input,
+	}
+	mock.lockActivityCount.Lock()
+	mock.calls.ActivityCount = append(mock.calls.ActivityCount, callInfo)
+	mock.lockActivityCount.Unlock()
+	return mock.ActivityCountFunc(input)
+}
+
+// ActivityCountCalls gets all the calls that were made to ActivityCount.
+func (mock *HotspotAPIMock) ActivityCountCalls() []struct {
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Input *helium.HotspotInput
+	}
+	mock.lockActivityCount.RLock()
+	calls = mock.calls.ActivityCount
+	mock.lockActivityCount.RUnlock()
+	return calls
+}
+
+// ActivityCountWithContext calls ActivityCountWithContextFunc.
+func (mock *HotspotAPIMock) ActivityCountWithContext(ctx context.Context, input *helium.HotspotInput) (*helium.HotspotActivityCount, error) {
+	if mock.ActivityCountWithContextFunc == nil {
+		panic("HotspotAPIMock.ActivityCountWithContextFunc: method is nil but HotspotAPI.ActivityCountWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockActivityCountWithContext.Lock()
+	mock.calls.ActivityCountWithContext = append(mock.calls.ActivityCountWithContext, callInfo)
+	mock.lockActivityCountWithContext.Unlock()
+	return mock.ActivityCountWithContextFunc(ctx, input)
+}
+
+// ActivityCountWithContextCalls gets all the calls that were made to ActivityCountWithContext.
+func (mock *HotspotAPIMock) ActivityCountWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}
+	mock.lockActivityCountWithContext.RLock()
+	calls = mock.calls.ActivityCountWithContext
+	mock.lockActivityCountWithContext.RUnlock()
+	return calls
+}
+
+// Elections calls ElectionsFunc.
+func (mock *HotspotAPIMock) Elections(input *helium.HotspotInput) (*helium.Elections, error) {
+	if mock.ElectionsFunc == nil {
+		panic("HotspotAPIMock.ElectionsFunc: method is nil but HotspotAPI.Elections was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotInput
+	}{
+		Input: input,
+	}
+	mock.lockElections.Lock()
+	mock.calls.Elections = append(mock.calls.Elections, callInfo)
+	mock.lockElections.Unlock()
+	return mock.ElectionsFunc(input)
+}
+
+// ElectionsCalls gets all the calls that were made to Elections.
+func (mock *HotspotAPIMock) ElectionsCalls() []struct {
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Input *helium.HotspotInput
+	}
+	mock.lockElections.RLock()
+	calls = mock.calls.Elections
+	mock.lockElections.RUnlock()
+	return calls
+}
+
+// ElectionsWithContext calls ElectionsWithContextFunc.
+func (mock *HotspotAPIMock) ElectionsWithContext(ctx context.Context, input *helium.HotspotInput) (*helium.Elections, error) {
+	if mock.ElectionsWithContextFunc == nil {
+		panic("HotspotAPIMock.ElectionsWithContextFunc: method is nil but HotspotAPI.ElectionsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockElectionsWithContext.Lock()
+	mock.calls.ElectionsWithContext = append(mock.calls.ElectionsWithContext, callInfo)
+	mock.lockElectionsWithContext.Unlock()
+	return mock.ElectionsWithContextFunc(ctx, input)
+}
+
+// ElectionsWithContextCalls gets all the calls that were made to ElectionsWithContext.
+func (mock *HotspotAPIMock) ElectionsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}
+	mock.lockElectionsWithContext.RLock()
+	calls = mock.calls.ElectionsWithContext
+	mock.lockElectionsWithContext.RUnlock()
+	return calls
+}
+
+// ElectionsAll calls ElectionsAllFunc.
+func (mock *HotspotAPIMock) ElectionsAll(input *helium.HotspotInput, opts ...helium.PagerOption) *helium.ElectionPager {
+	if mock.ElectionsAllFunc == nil {
+		panic("HotspotAPIMock.ElectionsAllFunc: method is nil but HotspotAPI.ElectionsAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockElectionsAll.Lock()
+	mock.calls.ElectionsAll = append(mock.calls.ElectionsAll, callInfo)
+	mock.lockElectionsAll.Unlock()
+	return mock.ElectionsAllFunc(input, opts...)
+}
+
+// ElectionsAllCalls gets all the calls that were made to ElectionsAll.
+func (mock *HotspotAPIMock) ElectionsAllCalls() []struct {
+	Input *helium.HotspotInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.HotspotInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockElectionsAll.RLock()
+	calls = mock.calls.ElectionsAll
+	mock.lockElectionsAll.RUnlock()
+	return calls
+}
+
+// CurrentlyElected calls CurrentlyElectedFunc.
+func (mock *HotspotAPIMock) CurrentlyElected() (*helium.Elections, error) {
+	if mock.CurrentlyElectedFunc == nil {
+		panic("HotspotAPIMock.CurrentlyElectedFunc: method is nil but HotspotAPI.CurrentlyElected was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockCurrentlyElected.Lock()
+	mock.calls.CurrentlyElected = append(mock.calls.CurrentlyElected, callInfo)
+	mock.lockCurrentlyElected.Unlock()
+	return mock.CurrentlyElectedFunc()
+}
+
+// CurrentlyElectedCalls gets all the calls that were made to CurrentlyElected.
+func (mock *HotspotAPIMock) CurrentlyElectedCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockCurrentlyElected.RLock()
+	calls = mock.calls.CurrentlyElected
+	mock.lockCurrentlyElected.RUnlock()
+	return calls
+}
+
+// CurrentlyElectedWithContext calls CurrentlyElectedWithContextFunc.
+func (mock *HotspotAPIMock) CurrentlyElectedWithContext(ctx context.Context) (*helium.Elections, error) {
+	if mock.CurrentlyElectedWithContextFunc == nil {
+		panic("HotspotAPIMock.CurrentlyElectedWithContextFunc: method is nil but HotspotAPI.CurrentlyElectedWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCurrentlyElectedWithContext.Lock()
+	mock.calls.CurrentlyElectedWithContext = append(mock.calls.CurrentlyElectedWithContext, callInfo)
+	mock.lockCurrentlyElectedWithContext.Unlock()
+	return mock.CurrentlyElectedWithContextFunc(ctx)
+}
+
+// CurrentlyElectedWithContextCalls gets all the calls that were made to CurrentlyElectedWithContext.
+func (mock *HotspotAPIMock) CurrentlyElectedWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCurrentlyElectedWithContext.RLock()
+	calls = mock.calls.CurrentlyElectedWithContext
+	mock.lockCurrentlyElectedWithContext.RUnlock()
+	return calls
+}
+
+// Challenges calls ChallengesFunc.
+func (mock *HotspotAPIMock) Challenges(input *helium.HotspotInput) (*helium.Challenges, error) {
+	if mock.ChallengesFunc == nil {
+		panic("HotspotAPIMock.ChallengesFunc: method is nil but HotspotAPI.Challenges was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotInput
+	}{
+		Input: input,
+	}
+	mock.lockChallenges.Lock()
+	mock.calls.Challenges = append(mock.calls.Challenges, callInfo)
+	mock.lockChallenges.Unlock()
+	return mock.ChallengesFunc(input)
+}
+
+// ChallengesCalls gets all the calls that were made to Challenges.
+func (mock *HotspotAPIMock) ChallengesCalls() []struct {
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Input *helium.HotspotInput
+	}
+	mock.lockChallenges.RLock()
+	calls = mock.calls.Challenges
+	mock.lockChallenges.RUnlock()
+	return calls
+}
+
+// ChallengesWithContext calls ChallengesWithContextFunc.
+func (mock *HotspotAPIMock) ChallengesWithContext(ctx context.Context, input *helium.HotspotInput) (*helium.Challenges, error) {
+	if mock.ChallengesWithContextFunc == nil {
+		panic("HotspotAPIMock.ChallengesWithContextFunc: method is nil but HotspotAPI.ChallengesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockChallengesWithContext.Lock()
+	mock.calls.ChallengesWithContext = append(mock.calls.ChallengesWithContext, callInfo)
+	mock.lockChallengesWithContext.Unlock()
+	return mock.ChallengesWithContextFunc(ctx, input)
+}
+
+// ChallengesWithContextCalls gets all the calls that were made to ChallengesWithContext.
+func (mock *HotspotAPIMock) ChallengesWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}
+	mock.lockChallengesWithContext.RLock()
+	calls = mock.calls.ChallengesWithContext
+	mock.lockChallengesWithContext.RUnlock()
+	return calls
+}
+
+// ChallengesAll calls ChallengesAllFunc.
+func (mock *HotspotAPIMock) ChallengesAll(input *helium.HotspotInput, opts ...helium.PagerOption) *helium.ChallengePager {
+	if mock.ChallengesAllFunc == nil {
+		panic("HotspotAPIMock.ChallengesAllFunc: method is nil but HotspotAPI.ChallengesAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockChallengesAll.Lock()
+	mock.calls.ChallengesAll = append(mock.calls.ChallengesAll, callInfo)
+	mock.lockChallengesAll.Unlock()
+	return mock.ChallengesAllFunc(input, opts...)
+}
+
+// ChallengesAllCalls gets all the calls that were made to ChallengesAll.
+func (mock *HotspotAPIMock) ChallengesAllCalls() []struct {
+	Input *helium.HotspotInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.HotspotInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockChallengesAll.RLock()
+	calls = mock.calls.ChallengesAll
+	mock.lockChallengesAll.RUnlock()
+	return calls
+}
+
+// Rewards calls RewardsFunc.
+func (mock *HotspotAPIMock) Rewards(input *helium.HotspotRewardsInput) (*helium.Rewards, error) {
+	if mock.RewardsFunc == nil {
+		panic("HotspotAPIMock.RewardsFunc: method is nil but HotspotAPI.Rewards was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotRewardsInput
+	}{
+		Input: input,
+	}
+	mock.lockRewards.Lock()
+	mock.calls.Rewards = append(mock.calls.Rewards, callInfo)
+	mock.lockRewards.Unlock()
+	return mock.RewardsFunc(input)
+}
+
+// RewardsCalls gets all the calls that were made to Rewards.
+func (mock *HotspotAPIMock) RewardsCalls() []struct {
+	Input *helium.HotspotRewardsInput
+} {
+	var calls []struct {
+		Input *helium.HotspotRewardsInput
+	}
+	mock.lockRewards.RLock()
+	calls = mock.calls.Rewards
+	mock.lockRewards.RUnlock()
+	return calls
+}
+
+// RewardsWithContext calls RewardsWithContextFunc.
+func (mock *HotspotAPIMock) RewardsWithContext(ctx context.Context, input *helium.HotspotRewardsInput) (*helium.Rewards, error) {
+	if mock.RewardsWithContextFunc == nil {
+		panic("HotspotAPIMock.RewardsWithContextFunc: method is nil but HotspotAPI.RewardsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotRewardsInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRewardsWithContext.Lock()
+	mock.calls.RewardsWithContext = append(mock.calls.RewardsWithContext, callInfo)
+	mock.lockRewardsWithContext.Unlock()
+	return mock.RewardsWithContextFunc(ctx, input)
+}
+
+// RewardsWithContextCalls gets all the calls that were made to RewardsWithContext.
+func (mock *HotspotAPIMock) RewardsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotRewardsInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotRewardsInput
+	}
+	mock.lockRewardsWithContext.RLock()
+	calls = mock.calls.RewardsWithContext
+	mock.lockRewardsWithContext.RUnlock()
+	return calls
+}
+
+// RewardsAll calls RewardsAllFunc.
+func (mock *HotspotAPIMock) RewardsAll(input *helium.HotspotRewardsInput, opts ...helium.PagerOption) *helium.RewardPager {
+	if mock.RewardsAllFunc == nil {
+		panic("HotspotAPIMock.RewardsAllFunc: method is nil but HotspotAPI.RewardsAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotRewardsInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockRewardsAll.Lock()
+	mock.calls.RewardsAll = append(mock.calls.RewardsAll, callInfo)
+	mock.lockRewardsAll.Unlock()
+	return mock.RewardsAllFunc(input, opts...)
+}
+
+// RewardsAllCalls gets all the calls that were made to RewardsAll.
+func (mock *HotspotAPIMock) RewardsAllCalls() []struct {
+	Input *helium.HotspotRewardsInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.HotspotRewardsInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockRewardsAll.RLock()
+	calls = mock.calls.RewardsAll
+	mock.lockRewardsAll.RUnlock()
+	return calls
+}
+
+// RewardSum calls RewardSumFunc.
+func (mock *HotspotAPIMock) RewardSum(input *helium.HotspotRewardsInput) (*helium.RewardSum, error) {
+	if mock.RewardSumFunc == nil {
+		panic("HotspotAPIMock.RewardSumFunc: method is nil but HotspotAPI.RewardSum was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotRewardsInput
+	}{
+		Input: input,
+	}
+	mock.lockRewardSum.Lock()
+	mock.calls.RewardSum = append(mock.calls.RewardSum, callInfo)
+	mock.lockRewardSum.Unlock()
+	return mock.RewardSumFunc(input)
+}
+
+// RewardSumCalls gets all the calls that were made to RewardSum.
+func (mock *HotspotAPIMock) RewardSumCalls() []struct {
+	Input *helium.HotspotRewardsInput
+} {
+	var calls []struct {
+		Input *helium.HotspotRewardsInput
+	}
+	mock.lockRewardSum.RLock()
+	calls = mock.calls.RewardSum
+	mock.lockRewardSum.RUnlock()
+	return calls
+}
+
+// RewardSumWithContext calls RewardSumWithContextFunc.
+func (mock *HotspotAPIMock) RewardSumWithContext(ctx context.Context, input *helium.HotspotRewardsInput) (*helium.RewardSum, error) {
+	if mock.RewardSumWithContextFunc == nil {
+		panic("HotspotAPIMock.RewardSumWithContextFunc: method is nil but HotspotAPI.RewardSumWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotRewardsInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRewardSumWithContext.Lock()
+	mock.calls.RewardSumWithContext = append(mock.calls.RewardSumWithContext, callInfo)
+	mock.lockRewardSumWithContext.Unlock()
+	return mock.RewardSumWithContextFunc(ctx, input)
+}
+
+// RewardSumWithContextCalls gets all the calls that were made to RewardSumWithContext.
+func (mock *HotspotAPIMock) RewardSumWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotRewardsInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotRewardsInput
+	}
+	mock.lockRewardSumWithContext.RLock()
+	calls = mock.calls.RewardSumWithContext
+	mock.lockRewardSumWithContext.RUnlock()
+	return calls
+}
+
+// RewardSumBuckets calls RewardSumBucketsFunc.
+func (mock *HotspotAPIMock) RewardSumBuckets(input *helium.HotspotRewardsInput, bucket helium.Bucket) (*helium.RewardBuckets, error) {
+	if mock.RewardSumBucketsFunc == nil {
+		panic("HotspotAPIMock.RewardSumBucketsFunc: method is nil but HotspotAPI.RewardSumBuckets was just called")
+	}
+	callInfo := struct {
+		Input  *helium.HotspotRewardsInput
+		Bucket helium.Bucket
+	}{
+		Input:  input,
+		Bucket: bucket,
+	}
+	mock.lockRewardSumBuckets.Lock()
+	mock.calls.RewardSumBuckets = append(mock.calls.RewardSumBuckets, callInfo)
+	mock.lockRewardSumBuckets.Unlock()
+	return mock.RewardSumBucketsFunc(input, bucket)
+}
+
+// RewardSumBucketsCalls gets all the calls that were made to RewardSumBuckets.
+func (mock *HotspotAPIMock) RewardSumBucketsCalls() []struct {
+	Input  *helium.HotspotRewardsInput
+	Bucket helium.Bucket
+} {
+	var calls []struct {
+		Input  *helium.HotspotRewardsInput
+		Bucket helium.Bucket
+	}
+	mock.lockRewardSumBuckets.RLock()
+	calls = mock.calls.RewardSumBuckets
+	mock.lockRewardSumBuckets.RUnlock()
+	return calls
+}
+
+// RewardSumBucketsWithContext calls RewardSumBucketsWithContextFunc.
+func (mock *HotspotAPIMock) RewardSumBucketsWithContext(ctx context.Context, input *helium.HotspotRewardsInput, bucket helium.Bucket) (*helium.RewardBuckets, error) {
+	if mock.RewardSumBucketsWithContextFunc == nil {
+		panic("HotspotAPIMock.RewardSumBucketsWithContextFunc: method is nil but HotspotAPI.RewardSumBucketsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Input  *helium.HotspotRewardsInput
+		Bucket helium.Bucket
+	}{
+		Ctx:    ctx,
+		Input:  input,
+		Bucket: bucket,
+	}
+	mock.lockRewardSumBucketsWithContext.Lock()
+	mock.calls.RewardSumBucketsWithContext = append(mock.calls.RewardSumBucketsWithContext, callInfo)
+	mock.lockRewardSumBucketsWithContext.Unlock()
+	return mock.RewardSumBucketsWithContextFunc(ctx, input, bucket)
+}
+
+// RewardSumBucketsWithContextCalls gets all the calls that were made to RewardSumBucketsWithContext.
+func (mock *HotspotAPIMock) RewardSumBucketsWithContextCalls() []struct {
+	Ctx    context.Context
+	Input  *helium.HotspotRewardsInput
+	Bucket helium.Bucket
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Input  *helium.HotspotRewardsInput
+		Bucket helium.Bucket
+	}
+	mock.lockRewardSumBucketsWithContext.RLock()
+	calls = mock.calls.RewardSumBucketsWithContext
+	mock.lockRewardSumBucketsWithContext.RUnlock()
+	return calls
+}
+
+// Witnesses calls WitnessesFunc.
+func (mock *HotspotAPIMock) Witnesses(input *helium.HotspotInput) (*helium.Witnesses, error) {
+	if mock.WitnessesFunc == nil {
+		panic("HotspotAPIMock.WitnessesFunc: method is nil but HotspotAPI.Witnesses was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotInput
+	}{
+		Input: input,
+	}
+	mock.lockWitnesses.Lock()
+	mock.calls.Witnesses = append(mock.calls.Witnesses, callInfo)
+	mock.lockWitnesses.Unlock()
+	return mock.WitnessesFunc(input)
+}
+
+// WitnessesCalls gets all the calls that were made to Witnesses.
+func (mock *HotspotAPIMock) WitnessesCalls() []struct {
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Input *helium.HotspotInput
+	}
+	mock.lockWitnesses.RLock()
+	calls = mock.calls.Witnesses
+	mock.lockWitnesses.RUnlock()
+	return calls
+}
+
+// WitnessesWithContext calls WitnessesWithContextFunc.
+func (mock *HotspotAPIMock) WitnessesWithContext(ctx context.Context, input *helium.HotspotInput) (*helium.Witnesses, error) {
+	if mock.WitnessesWithContextFunc == nil {
+		panic("HotspotAPIMock.WitnessesWithContextFunc: method is nil but HotspotAPI.WitnessesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockWitnessesWithContext.Lock()
+	mock.calls.WitnessesWithContext = append(mock.calls.WitnessesWithContext, callInfo)
+	mock.lockWitnessesWithContext.Unlock()
+	return mock.WitnessesWithContextFunc(ctx, input)
+}
+
+// WitnessesWithContextCalls gets all the calls that were made to WitnessesWithContext.
+func (mock *HotspotAPIMock) WitnessesWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}
+	mock.lockWitnessesWithContext.RLock()
+	calls = mock.calls.WitnessesWithContext
+	mock.lockWitnessesWithContext.RUnlock()
+	return calls
+}
+
+// Witnessed calls WitnessedFunc.
+func (mock *HotspotAPIMock) Witnessed(input *helium.HotspotInput) (*helium.Witnesses, error) {
+	if mock.WitnessedFunc == nil {
+		panic("HotspotAPIMock.WitnessedFunc: method is nil but HotspotAPI.Witnessed was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotInput
+	}{
+		Input: input,
+	}
+	mock.lockWitnessed.Lock()
+	mock.calls.Witnessed = append(mock.calls.Witnessed, callInfo)
+	mock.lockWitnessed.Unlock()
+	return mock.WitnessedFunc(input)
+}
+
+// WitnessedCalls gets all the calls that were made to Witnessed.
+func (mock *HotspotAPIMock) WitnessedCalls() []struct {
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Input *helium.HotspotInput
+	}
+	mock.lockWitnessed.RLock()
+	calls = mock.calls.Witnessed
+	mock.lockWitnessed.RUnlock()
+	return calls
+}
+
+// WitnessedWithContext calls WitnessedWithContextFunc.
+func (mock *HotspotAPIMock) WitnessedWithContext(ctx context.Context, input *helium.HotspotInput) (*helium.Witnesses, error) {
+	if mock.WitnessedWithContextFunc == nil {
+		panic("HotspotAPIMock.WitnessedWithContextFunc: method is nil but HotspotAPI.WitnessedWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockWitnessedWithContext.Lock()
+	mock.calls.WitnessedWithContext = append(mock.calls.WitnessedWithContext, callInfo)
+	mock.lockWitnessedWithContext.Unlock()
+	return mock.WitnessedWithContextFunc(ctx, input)
+}
+
+// WitnessedWithContextCalls gets all the calls that were made to WitnessedWithContext.
+func (mock *HotspotAPIMock) WitnessedWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotInput
+	}
+	mock.lockWitnessedWithContext.RLock()
+	calls = mock.calls.WitnessedWithContext
+	mock.lockWitnessedWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that BlockAPIMock does implement helium.BlockAPI.
+var _ helium.BlockAPI = &BlockAPIMock{}
+
+// BlockAPIMock is a mock implementation of helium.BlockAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type BlockAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.BlockCursorInput) (*helium.Blocks, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.BlockCursorInput) (*helium.Blocks, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(opts ...helium.PagerOption) *helium.BlockPager
+
+	// GetFunc mocks the Get method.
+	GetFunc func(input *helium.BlockInput) (*helium.Block, error)
+
+	// GetWithContextFunc mocks the GetWithContext method.
+	GetWithContextFunc func(ctx context.Context, input *helium.BlockInput) (*helium.Block, error)
+
+	// CurrentHeightFunc mocks the CurrentHeight method.
+	CurrentHeightFunc func(input *helium.BlockCursorInput) (*helium.Height, error)
+
+	// CurrentHeightWithContextFunc mocks the CurrentHeightWithContext method.
+	CurrentHeightWithContextFunc func(ctx context.Context, input *helium.BlockCursorInput) (*helium.Height, error)
+
+	// StatsFunc mocks the Stats method.
+	StatsFunc func(input *helium.BlockCursorInput) (*helium.BlockStats, error)
+
+	// StatsWithContextFunc mocks the StatsWithContext method.
+	StatsWithContextFunc func(ctx context.Context, input *helium.BlockCursorInput) (*helium.BlockStats, error)
+
+	// GetHeightFunc mocks the GetHeight method.
+	GetHeightFunc func(input *helium.BlockInput) (*helium.BlockHeight, error)
+
+	// GetHeightWithContextFunc mocks the GetHeightWithContext method.
+	GetHeightWithContextFunc func(ctx context.Context, input *helium.BlockInput) (*helium.BlockHeight, error)
+
+	// TransactionsFunc mocks the Transactions method.
+	TransactionsFunc func(input *helium.BlockInput) (*helium.Transactions, error)
+
+	// TransactionsWithContextFunc mocks the TransactionsWithContext method.
+	TransactionsWithContextFunc func(ctx context.Context, input *helium.BlockInput) (*helium.Transactions, error)
+
+	// TransactionsAllFunc mocks the TransactionsAll method.
+	TransactionsAllFunc func(input *helium.BlockInput, opts ...helium.PagerOption) *helium.TransactionPager
+
+	calls struct {
+		List []struct {
+			Input *helium.BlockCursorInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.BlockCursorInput
+		}
+		ListAll []struct {
+			Opts []helium.PagerOption
+		}
+		Get []struct {
+			Input *helium.BlockInput
+		}
+		GetWithContext []struct {
+			Ctx   context.Context
+			Input *helium.BlockInput
+		}
+		CurrentHeight []struct {
+			Input *helium.BlockCursorInput
+		}
+		CurrentHeightWithContext []struct {
+			Ctx   context.Context
+			Input *helium.BlockCursorInput
+		}
+		Stats []struct {
+			Input *helium.BlockCursorInput
+		}
+		StatsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.BlockCursorInput
+		}
+		GetHeight []struct {
+			Input *helium.BlockInput
+		}
+		GetHeightWithContext []struct {
+			Ctx   context.Context
+			Input *helium.BlockInput
+		}
+		Transactions []struct {
+			Input *helium.BlockInput
+		}
+		TransactionsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.BlockInput
+		}
+		TransactionsAll []struct {
+			Input *helium.BlockInput
+			Opts  []helium.PagerOption
+		}
+	}
+	lockList                     sync.RWMutex
+	lockListWithContext          sync.RWMutex
+	lockListAll                  sync.RWMutex
+	lockGet                      sync.RWMutex
+	lockGetWithContext           sync.RWMutex
+	lockCurrentHeight            sync.RWMutex
+	lockCurrentHeightWithContext sync.RWMutex
+	lockStats                    sync.RWMutex
+	lockStatsWithContext         sync.RWMutex
+	lockGetHeight                sync.RWMutex
+	lockGetHeightWithContext     sync.RWMutex
+	lockTransactions             sync.RWMutex
+	lockTransactionsWithContext  sync.RWMutex
+	lockTransactionsAll          sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *BlockAPIMock) List(input *helium.BlockCursorInput) (*helium.Blocks, error) {
+	if mock.ListFunc == nil {
+		panic("BlockAPIMock.ListFunc: method is nil but BlockAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.BlockCursorInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *BlockAPIMock) ListCalls() []struct {
+	Input *helium.BlockCursorInput
+} {
+	var calls []struct {
+		Input *helium.BlockCursorInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *BlockAPIMock) ListWithContext(ctx context.Context, input *helium.BlockCursorInput) (*helium.Blocks, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("BlockAPIMock.ListWithContextFunc: method is nil but BlockAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.BlockCursorInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *BlockAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.BlockCursorInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.BlockCursorInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *BlockAPIMock) ListAll(opts ...helium.PagerOption) *helium.BlockPager {
+	if mock.ListAllFunc == nil {
+		panic("BlockAPIMock.ListAllFunc: method is nil but BlockAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Opts []helium.PagerOption
+	}{
+		Opts: opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *BlockAPIMock) ListAllCalls() []struct {
+	Opts []helium.PagerOption
+} {
+	var calls []struct {
+		Opts []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *BlockAPIMock) Get(input *helium.BlockInput) (*helium.Block, error) {
+	if mock.GetFunc == nil {
+		panic("BlockAPIMock.GetFunc: method is nil but BlockAPI.Get was just called")
+	}
+	callInfo := struct {
+		Input *helium.BlockInput
+	}{
+		Input: input,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(input)
+}
+
+// GetCalls gets all the calls that were made to Get.
+func (mock *BlockAPIMock) GetCalls() []struct {
+	Input *helium.BlockInput
+} {
+	var calls []struct {
+		Input *helium.BlockInput
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (mock *BlockAPIMock) GetWithContext(ctx context.Context, input *helium.BlockInput) (*helium.Block, error) {
+	if mock.GetWithContextFunc == nil {
+		panic("BlockAPIMock.GetWithContextFunc: method is nil but BlockAPI.GetWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.BlockInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockGetWithContext.Lock()
+	mock.calls.GetWithContext = append(mock.calls.GetWithContext, callInfo)
+	mock.lockGetWithContext.Unlock()
+	return mock.GetWithContextFunc(ctx, input)
+}
+
+// GetWithContextCalls gets all the calls that were made to GetWithContext.
+func (mock *BlockAPIMock) GetWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.BlockInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.BlockInput
+	}
+	mock.lockGetWithContext.RLock()
+	calls = mock.calls.GetWithContext
+	mock.lockGetWithContext.RUnlock()
+	return calls
+}
+
+// CurrentHeight calls CurrentHeightFunc.
+func (mock *BlockAPIMock) CurrentHeight(input *helium.BlockCursorInput) (*helium.Height, error) {
+	if mock.CurrentHeightFunc == nil {
+		panic("BlockAPIMock.CurrentHeightFunc: method is nil but BlockAPI.CurrentHeight was just called")
+	}
+	callInfo := struct {
+		Input *helium.BlockCursorInput
+	}{
+		Input: input,
+	}
+	mock.lockCurrentHeight.Lock()
+	mock.calls.CurrentHeight = append(mock.calls.CurrentHeight, callInfo)
+	mock.lockCurrentHeight.Unlock()
+	return mock.CurrentHeightFunc(input)
+}
+
+// CurrentHeightCalls gets all the calls that were made to CurrentHeight.
+func (mock *BlockAPIMock) CurrentHeightCalls() []struct {
+	Input *helium.BlockCursorInput
+} {
+	var calls []struct {
+		Input *helium.BlockCursorInput
+	}
+	mock.lockCurrentHeight.RLock()
+	calls = mock.calls.CurrentHeight
+	mock.lockCurrentHeight.RUnlock()
+	return calls
+}
+
+// CurrentHeightWithContext calls CurrentHeightWithContextFunc.
+func (mock *BlockAPIMock) CurrentHeightWithContext(ctx context.Context, input *helium.BlockCursorInput) (*helium.Height, error) {
+	if mock.CurrentHeightWithContextFunc == nil {
+		panic("BlockAPIMock.CurrentHeightWithContextFunc: method is nil but BlockAPI.CurrentHeightWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.BlockCursorInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCurrentHeightWithContext.Lock()
+	mock.calls.CurrentHeightWithContext = append(mock.calls.CurrentHeightWithContext, callInfo)
+	mock.lockCurrentHeightWithContext.Unlock()
+	return mock.CurrentHeightWithContextFunc(ctx, input)
+}
+
+// CurrentHeightWithContextCalls gets all the calls that were made to CurrentHeightWithContext.
+func (mock *BlockAPIMock) CurrentHeightWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.BlockCursorInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.BlockCursorInput
+	}
+	mock.lockCurrentHeightWithContext.RLock()
+	calls = mock.calls.CurrentHeightWithContext
+	mock.lockCurrentHeightWithContext.RUnlock()
+	return calls
+}
+
+// Stats calls StatsFunc.
+func (mock *BlockAPIMock) Stats(input *helium.BlockCursorInput) (*helium.BlockStats, error) {
+	if mock.StatsFunc == nil {
+		panic("BlockAPIMock.StatsFunc: method is nil but BlockAPI.Stats was just called")
+	}
+	callInfo := struct {
+		Input *helium.BlockCursorInput
+	}{
+		Input: input,
+	}
+	mock.lockStats.Lock()
+	mock.calls.Stats = append(mock.calls.Stats, callInfo)
+	mock.lockStats.Unlock()
+	return mock.StatsFunc(input)
+}
+
+// StatsCalls gets all the calls that were made to Stats.
+func (mock *BlockAPIMock) StatsCalls() []struct {
+	Input *helium.BlockCursorInput
+} {
+	var calls []struct {
+		Input *helium.BlockCursorInput
+	}
+	mock.lockStats.RLock()
+	calls = mock.calls.Stats
+	mock.lockStats.RUnlock()
+	return calls
+}
+
+// StatsWithContext calls StatsWithContextFunc.
+func (mock *BlockAPIMock) StatsWithContext(ctx context.Context, input *helium.BlockCursorInput) (*helium.BlockStats, error) {
+	if mock.StatsWithContextFunc == nil {
+		panic("BlockAPIMock.StatsWithContextFunc: method is nil but BlockAPI.StatsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.BlockCursorInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockStatsWithContext.Lock()
+	mock.calls.StatsWithContext = append(mock.calls.StatsWithContext, callInfo)
+	mock.lockStatsWithContext.Unlock()
+	return mock.StatsWithContextFunc(ctx, input)
+}
+
+// StatsWithContextCalls gets all the calls that were made to StatsWithContext.
+func (mock *BlockAPIMock) StatsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.BlockCursorInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.BlockCursorInput
+	}
+	mock.lockStatsWithContext.RLock()
+	calls = mock.calls.StatsWithContext
+	mock.lockStatsWithContext.RUnlock()
+	return calls
+}
+
+// GetHeight calls GetHeightFunc.
+func (mock *BlockAPIMock) GetHeight(input *helium.BlockInput) (*helium.BlockHeight, error) {
+	if mock.GetHeightFunc == nil {
+		panic("BlockAPIMock.GetHeightFunc: method is nil but BlockAPI.GetHeight was just called")
+	}
+	callInfo := struct {
+		Input *helium.BlockInput
+	}{
+		Input: input,
+	}
+	mock.lockGetHeight.Lock()
+	mock.calls.GetHeight = append(mock.calls.GetHeight, callInfo)
+	mock.lockGetHeight.Unlock()
+	return mock.GetHeightFunc(input)
+}
+
+// GetHeightCalls gets all the calls that were made to GetHeight.
+func (mock *BlockAPIMock) GetHeightCalls() []struct {
+	Input *helium.BlockInput
+} {
+	var calls []struct {
+		Input *helium.BlockInput
+	}
+	mock.lockGetHeight.RLock()
+	calls = mock.calls.GetHeight
+	mock.lockGetHeight.RUnlock()
+	return calls
+}
+
+// GetHeightWithContext calls GetHeightWithContextFunc.
+func (mock *BlockAPIMock) GetHeightWithContext(ctx context.Context, input *helium.BlockInput) (*helium.BlockHeight, error) {
+	if mock.GetHeightWithContextFunc == nil {
+		panic("BlockAPIMock.GetHeightWithContextFunc: method is nil but BlockAPI.GetHeightWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.BlockInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockGetHeightWithContext.Lock()
+	mock.calls.GetHeightWithContext = append(mock.calls.GetHeightWithContext, callInfo)
+	mock.lockGetHeightWithContext.Unlock()
+	return mock.GetHeightWithContextFunc(ctx, input)
+}
+
+// GetHeightWithContextCalls gets all the calls that were made to GetHeightWithContext.
+func (mock *BlockAPIMock) GetHeightWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.BlockInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.BlockInput
+	}
+	mock.lockGetHeightWithContext.RLock()
+	calls = mock.calls.GetHeightWithContext
+	mock.lockGetHeightWithContext.RUnlock()
+	return calls
+}
+
+// Transactions calls TransactionsFunc.
+func (mock *BlockAPIMock) Transactions(input *helium.BlockInput) (*helium.Transactions, error) {
+	if mock.TransactionsFunc == nil {
+		panic("BlockAPIMock.TransactionsFunc: method is nil but BlockAPI.Transactions was just called")
+	}
+	callInfo := struct {
+		Input *helium.BlockInput
+	}{
+		Input: input,
+	}
+	mock.lockTransactions.Lock()
+	mock.calls.Transactions = append(mock.calls.Transactions, callInfo)
+	mock.lockTransactions.Unlock()
+	return mock.TransactionsFunc(input)
+}
+
+// TransactionsCalls gets all the calls that were made to Transactions.
+func (mock *BlockAPIMock) TransactionsCalls() []struct {
+	Input *helium.BlockInput
+} {
+	var calls []struct {
+		Input *helium.BlockInput
+	}
+	mock.lockTransactions.RLock()
+	calls = mock.calls.Transactions
+	mock.lockTransactions.RUnlock()
+	return calls
+}
+
+// TransactionsWithContext calls TransactionsWithContextFunc.
+func (mock *BlockAPIMock) TransactionsWithContext(ctx context.Context, input *helium.BlockInput) (*helium.Transactions, error) {
+	if mock.TransactionsWithContextFunc == nil {
+		panic("BlockAPIMock.TransactionsWithContextFunc: method is nil but BlockAPI.TransactionsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.BlockInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockTransactionsWithContext.Lock()
+	mock.calls.TransactionsWithContext = append(mock.calls.TransactionsWithContext, callInfo)
+	mock.lockTransactionsWithContext.Unlock()
+	return mock.TransactionsWithContextFunc(ctx, input)
+}
+
+// TransactionsWithContextCalls gets all the calls that were made to TransactionsWithContext.
+func (mock *BlockAPIMock) TransactionsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.BlockInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.BlockInput
+	}
+	mock.lockTransactionsWithContext.RLock()
+	calls = mock.calls.TransactionsWithContext
+	mock.lockTransactionsWithContext.RUnlock()
+	return calls
+}
+
+// TransactionsAll calls TransactionsAllFunc.
+func (mock *BlockAPIMock) TransactionsAll(input *helium.BlockInput, opts ...helium.PagerOption) *helium.TransactionPager {
+	if mock.TransactionsAllFunc == nil {
+		panic("BlockAPIMock.TransactionsAllFunc: method is nil but BlockAPI.TransactionsAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.BlockInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockTransactionsAll.Lock()
+	mock.calls.TransactionsAll = append(mock.calls.TransactionsAll, callInfo)
+	mock.lockTransactionsAll.Unlock()
+	return mock.TransactionsAllFunc(input, opts...)
+}
+
+// TransactionsAllCalls gets all the calls that were made to TransactionsAll.
+func (mock *BlockAPIMock) TransactionsAllCalls() []struct {
+	Input *helium.BlockInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.BlockInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockTransactionsAll.RLock()
+	calls = mock.calls.TransactionsAll
+	mock.lockTransactionsAll.RUnlock()
+	return calls
+}
+
+// Ensure, that ValidatorAPIMock does implement helium.ValidatorAPI.
+var _ helium.ValidatorAPI = &ValidatorAPIMock{}
+
+// ValidatorAPIMock is a mock implementation of helium.ValidatorAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type ValidatorAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.ValidatorListInput) (*helium.Validators, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.ValidatorListInput) (*helium.Validators, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(opts ...helium.PagerOption) *helium.ValidatorPager
+
+	// GetFunc mocks the Get method.
+	GetFunc func(address helium.Address) (*helium.ValidatorInfo, error)
+
+	// GetWithContextFunc mocks the GetWithContext method.
+	GetWithContextFunc func(ctx context.Context, address helium.Address) (*helium.ValidatorInfo, error)
+
+	// GetByNameFunc mocks the GetByName method.
+	GetByNameFunc func(name string) (*helium.ValidatorInfo, error)
+
+	// GetByNameWithContextFunc mocks the GetByNameWithContext method.
+	GetByNameWithContextFunc func(ctx context.Context, name string) (*helium.ValidatorInfo, error)
+
+	// SearchFunc mocks the Search method.
+	SearchFunc func(term string) (*helium.Validators, error)
+
+	// SearchWithContextFunc mocks the SearchWithContext method.
+	SearchWithContextFunc func(ctx context.Context, term string) (*helium.Validators, error)
+
+	// ActivityFunc mocks the Activity method.
+	ActivityFunc func(input *helium.ValidatorActivityInput) (*helium.ValidatorActivity, error)
+
+	// ActivityWithContextFunc mocks the ActivityWithContext method.
+	ActivityWithContextFunc func(ctx context.Context, input *helium.ValidatorActivityInput) (*helium.ValidatorActivity, error)
+
+	// ActivityAllFunc mocks the ActivityAll method.
+	ActivityAllFunc func(input *helium.ValidatorActivityInput, opts ...helium.PagerOption) *helium.ValidatorActivityPager
+
+	// RolesFunc mocks the Roles method.
+	RolesFunc func(input *helium.ValidatorActivityInput) (*helium.Roles, error)
+
+	// RolesWithContextFunc mocks the RolesWithContext method.
+	RolesWithContextFunc func(ctx context.Context, input *helium.ValidatorActivityInput) (*helium.Roles, error)
+
+	// RolesAllFunc mocks the RolesAll method.
+	RolesAllFunc func(input *helium.ValidatorActivityInput, opts ...helium.PagerOption) *helium.RolePager
+
+	// ActivityCountFunc mocks the ActivityCount method.
+	ActivityCountFunc func(address helium.Address, filterTypes []helium.TxnType) (*helium.ValidatorActivityCount, error)
+
+	// ActivityCountWithContextFunc mocks the ActivityCountWithContext method.
+	ActivityCountWithContextFunc func(ctx context.Context, address helium.Address, filterTypes []helium.TxnType) (*helium.ValidatorActivityCount, error)
+
+	// StatsFunc mocks the Stats method.
+	StatsFunc func() (*helium.ValidatorStats, error)
+
+	// StatsWithContextFunc mocks the StatsWithContext method.
+	StatsWithContextFunc func(ctx context.Context) (*helium.ValidatorStats, error)
+
+	// ListElectedFunc mocks the ListElected method.
+	ListElectedFunc func() (*helium.ValidatorElections, error)
+
+	// ListElectedWithContextFunc mocks the ListElectedWithContext method.
+	ListElectedWithContextFunc func(ctx context.Context) (*helium.ValidatorElections, error)
+
+	// ElectedAtHeightFunc mocks the ElectedAtHeight method.
+	ElectedAtHeightFunc func(height string) (*helium.Validators, error)
+
+	// ElectedAtHeightWithContextFunc mocks the ElectedAtHeightWithContext method.
+	ElectedAtHeightWithContextFunc func(ctx context.Context, height string) (*helium.Validators, error)
+
+	// ElectedAtHashFunc mocks the ElectedAtHash method.
+	ElectedAtHashFunc func(hash string) (*helium.Validators, error)
+
+	// ElectedAtHashWithContextFunc mocks the ElectedAtHashWithContext method.
+	ElectedAtHashWithContextFunc func(ctx context.Context, hash string) (*helium.Validators, error)
+
+	// RewardsFunc mocks the Rewards method.
+	RewardsFunc func(address helium.Address, cursor string, maxTime helium.TimeBound, minTime helium.TimeBound) (*helium.ValidatorRewards, error)
+
+	// RewardsWithContextFunc mocks the RewardsWithContext method.
+	RewardsWithContextFunc func(ctx context.Context, address helium.Address, cursor string, maxTime helium.TimeBound, minTime helium.TimeBound) (*helium.ValidatorRewards, error)
+
+	// RewardsAllFunc mocks the RewardsAll method.
+	RewardsAllFunc func(address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound, opts ...helium.PagerOption) *helium.ValidatorRewardPager
+
+	// RewardsSumFunc mocks the RewardsSum method.
+	RewardsSumFunc func(address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound) (*helium.ValidatorRewardsSum, error)
+
+	// RewardsSumWithContextFunc mocks the RewardsSumWithContext method.
+	RewardsSumWithContextFunc func(ctx context.Context, address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound) (*helium.ValidatorRewardsSum, error)
+
+	// RewardsSumBucketsFunc mocks the RewardsSumBuckets method.
+	RewardsSumBucketsFunc func(address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound, bucket helium.Bucket) (*helium.RewardBuckets, error)
+
+	// RewardsSumBucketsWithContextFunc mocks the RewardsSumBucketsWithContext method.
+	RewardsSumBucketsWithContextFunc func(ctx context.Context, address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound, bucket helium.Bucket) (*helium.RewardBuckets, error)
+
+	calls struct {
+		List []struct {
+			Input *helium.ValidatorListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.ValidatorListInput
+		}
+		ListAll []struct {
+			Opts []helium.PagerOption
+		}
+		Get []struct {
+			Address helium.Address
+		}
+		GetWithContext []struct {
+			Ctx     context.Context
+			Address helium.Address
+		}
+		GetByName []struct {
+			Name string
+		}
+		GetByNameWithContext []struct {
+			Ctx  context.Context
+			Name string
+		}
+		Search []struct {
+			Term string
+		}
+		SearchWithContext []struct {
+			Ctx  context.Context
+			Term string
+		}
+		Activity []struct {
+			Input *helium.ValidatorActivityInput
+		}
+		ActivityWithContext []struct {
+			Ctx   context.Context
+			Input *helium.ValidatorActivityInput
+		}
+		ActivityAll []struct {
+			Input *helium.ValidatorActivityInput
+			Opts  []helium.PagerOption
+		}
+		Roles []struct {
+			Input *helium.ValidatorActivityInput
+		}
+		RolesWithContext []struct {
+			Ctx   context.Context
+			Input *helium.ValidatorActivityInput
+		}
+		RolesAll []struct {
+			Input *helium.ValidatorActivityInput
+			Opts  []helium.PagerOption
+		}
+		ActivityCount []struct {
+			Address     helium.Address
+			FilterTypes []helium.TxnType
+		}
+		ActivityCountWithContext []struct {
+			Ctx         context.Context
+			Address     helium.Address
+			FilterTypes []helium.TxnType
+		}
+		Stats []struct {
+		}
+		StatsWithContext []struct {
+			Ctx context.Context
+		}
+		ListElected []struct {
+		}
+		ListElectedWithContext []struct {
+			Ctx context.Context
+		}
+		ElectedAtHeight []struct {
+			Height string
+		}
+		ElectedAtHeightWithContext []struct {
+			Ctx    context.Context
+			Height string
+		}
+		ElectedAtHash []struct {
+			Hash string
+		}
+		ElectedAtHashWithContext []struct {
+			Ctx  context.Context
+			Hash string
+		}
+		Rewards []struct {
+			Address helium.Address
+			Cursor  string
+			MaxTime helium.TimeBound
+			MinTime helium.TimeBound
+		}
+		RewardsWithContext []struct {
+			Ctx     context.Context
+			Address helium.Address
+			Cursor  string
+			MaxTime helium.TimeBound
+			MinTime helium.TimeBound
+		}
+		RewardsAll []struct {
+			Address helium.Address
+			MaxTime helium.TimeBound
+			MinTime helium.TimeBound
+			Opts    []helium.PagerOption
+		}
+		RewardsSum []struct {
+			Address helium.Address
+			MaxTime helium.TimeBound
+			MinTime helium.TimeBound
+		}
+		RewardsSumWithContext []struct {
+			Ctx     context.Context
+			Address helium.Address
+			MaxTime helium.TimeBound
+			MinTime helium.TimeBound
+		}
+		RewardsSumBuckets []struct {
+			Address helium.Address
+			MaxTime helium.TimeBound
+			MinTime helium.TimeBound
+			Bucket  helium.Bucket
+		}
+		RewardsSumBucketsWithContext []struct {
+			Ctx     context.Context
+			Address helium.Address
+			MaxTime helium.TimeBound
+			MinTime helium.TimeBound
+			Bucket  helium.Bucket
+		}
+	}
+	lockList                         sync.RWMutex
+	lockListWithContext              sync.RWMutex
+	lockListAll                      sync.RWMutex
+	lockGet                          sync.RWMutex
+	lockGetWithContext               sync.RWMutex
+	lockGetByName                    sync.RWMutex
+	lockGetByNameWithContext         sync.RWMutex
+	lockSearch                       sync.RWMutex
+	lockSearchWithContext            sync.RWMutex
+	lockActivity                     sync.RWMutex
+	lockActivityWithContext          sync.RWMutex
+	lockActivityAll                  sync.RWMutex
+	lockRoles                        sync.RWMutex
+	lockRolesWithContext             sync.RWMutex
+	lockRolesAll                     sync.RWMutex
+	lockActivityCount                sync.RWMutex
+	lockActivityCountWithContext     sync.RWMutex
+	lockStats                        sync.RWMutex
+	lockStatsWithContext             sync.RWMutex
+	lockListElected                  sync.RWMutex
+	lockListElectedWithContext       sync.RWMutex
+	lockElectedAtHeight              sync.RWMutex
+	lockElectedAtHeightWithContext   sync.RWMutex
+	lockElectedAtHash                sync.RWMutex
+	lockElectedAtHashWithContext     sync.RWMutex
+	lockRewards                      sync.RWMutex
+	lockRewardsWithContext           sync.RWMutex
+	lockRewardsAll                   sync.RWMutex
+	lockRewardsSum                   sync.RWMutex
+	lockRewardsSumWithContext        sync.RWMutex
+	lockRewardsSumBuckets            sync.RWMutex
+	lockRewardsSumBucketsWithContext sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *ValidatorAPIMock) List(input *helium.ValidatorListInput) (*helium.Validators, error) {
+	if mock.ListFunc == nil {
+		panic("ValidatorAPIMock.ListFunc: method is nil but ValidatorAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.ValidatorListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *ValidatorAPIMock) ListCalls() []struct {
+	Input *helium.ValidatorListInput
+} {
+	var calls []struct {
+		Input *helium.ValidatorListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *ValidatorAPIMock) ListWithContext(ctx context.Context, input *helium.ValidatorListInput) (*helium.Validators, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("ValidatorAPIMock.ListWithContextFunc: method is nil but ValidatorAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.ValidatorListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *ValidatorAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.ValidatorListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.ValidatorListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *ValidatorAPIMock) ListAll(opts ...helium.PagerOption) *helium.ValidatorPager {
+	if mock.ListAllFunc == nil {
+		panic("ValidatorAPIMock.ListAllFunc: method is nil but ValidatorAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Opts []helium.PagerOption
+	}{
+		Opts: opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *ValidatorAPIMock) ListAllCalls() []struct {
+	Opts []helium.PagerOption
+} {
+	var calls []struct {
+		Opts []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *ValidatorAPIMock) Get(address helium.Address) (*helium.ValidatorInfo, error) {
+	if mock.GetFunc == nil {
+		panic("ValidatorAPIMock.GetFunc: method is nil but ValidatorAPI.Get was just called")
+	}
+	callInfo := struct {
+		Address helium.Address
+	}{
+		Address: address,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(address)
+}
+
+// GetCalls gets all the calls that were made to Get.
+func (mock *ValidatorAPIMock) GetCalls() []struct {
+	Address helium.Address
+} {
+	var calls []struct {
+		Address helium.Address
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (mock *ValidatorAPIMock) GetWithContext(ctx context.Context, address helium.Address) (*helium.ValidatorInfo, error) {
+	if mock.GetWithContextFunc == nil {
+		panic("ValidatorAPIMock.GetWithContextFunc: method is nil but ValidatorAPI.GetWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Address helium.Address
+	}{
+		Ctx:     ctx,
+		Address: address,
+	}
+	mock.lockGetWithContext.Lock()
+	mock.calls.GetWithContext = append(mock.calls.GetWithContext, callInfo)
+	mock.lockGetWithContext.Unlock()
+	return mock.GetWithContextFunc(ctx, address)
+}
+
+// GetWithContextCalls gets all the calls that were made to GetWithContext.
+func (mock *ValidatorAPIMock) GetWithContextCalls() []struct {
+	Ctx     context.Context
+	Address helium.Address
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Address helium.Address
+	}
+	mock.lockGetWithContext.RLock()
+	calls = mock.calls.GetWithContext
+	mock.lockGetWithContext.RUnlock()
+	return calls
+}
+
+// GetByName calls GetByNameFunc.
+func (mock *ValidatorAPIMock) GetByName(name string) (*helium.ValidatorInfo, error) {
+	if mock.GetByNameFunc == nil {
+		panic("ValidatorAPIMock.GetByNameFunc: method is nil but ValidatorAPI.GetByName was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockGetByName.Lock()
+	mock.calls.GetByName = append(mock.calls.GetByName, callInfo)
+	mock.lockGetByName.Unlock()
+	return mock.GetByNameFunc(name)
+}
+
+// GetByNameCalls gets all the calls that were made to GetByName.
+func (mock *ValidatorAPIMock) GetByNameCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockGetByName.RLock()
+	calls = mock.calls.GetByName
+	mock.lockGetByName.RUnlock()
+	return calls
+}
+
+// GetByNameWithContext calls GetByNameWithContextFunc.
+func (mock *ValidatorAPIMock) GetByNameWithContext(ctx context.Context, name string) (*helium.ValidatorInfo, error) {
+	if mock.GetByNameWithContextFunc == nil {
+		panic("ValidatorAPIMock.GetByNameWithContextFunc: method is nil but ValidatorAPI.GetByNameWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetByNameWithContext.Lock()
+	mock.calls.GetByNameWithContext = append(mock.calls.GetByNameWithContext, callInfo)
+	mock.lockGetByNameWithContext.Unlock()
+	return mock.GetByNameWithContextFunc(ctx, name)
+}
+
+// GetByNameWithContextCalls gets all the calls that were made to GetByNameWithContext.
+func (mock *ValidatorAPIMock) GetByNameWithContextCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetByNameWithContext.RLock()
+	calls = mock.calls.GetByNameWithContext
+	mock.lockGetByNameWithContext.RUnlock()
+	return calls
+}
+
+// Search calls SearchFunc.
+func (mock *ValidatorAPIMock) Search(term string) (*helium.Validators, error) {
+	if mock.SearchFunc == nil {
+		panic("ValidatorAPIMock.SearchFunc: method is nil but ValidatorAPI.Search was just called")
+	}
+	callInfo := struct {
+		Term string
+	}{
+		Term: term,
+	}
+	mock.lockSearch.Lock()
+	mock.calls.Search = append(mock.calls.Search, callInfo)
+	mock.lockSearch.Unlock()
+	return mock.SearchFunc(term)
+}
+
+// SearchCalls gets all the calls that were made to Search.
+func (mock *ValidatorAPIMock) SearchCalls() []struct {
+	Term string
+} {
+	var calls []struct {
+		Term string
+	}
+	mock.lockSearch.RLock()
+	calls = mock.calls.Search
+	mock.lockSearch.RUnlock()
+	return calls
+}
+
+// SearchWithContext calls SearchWithContextFunc.
+func (mock *ValidatorAPIMock) SearchWithContext(ctx context.Context, term string) (*helium.Validators, error) {
+	if mock.SearchWithContextFunc == nil {
+		panic("ValidatorAPIMock.SearchWithContextFunc: method is nil but ValidatorAPI.SearchWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Term string
+	}{
+		Ctx:  ctx,
+		Term: term,
+	}
+	mock.lockSearchWithContext.Lock()
+	mock.calls.SearchWithContext = append(mock.calls.SearchWithContext, callInfo)
+	mock.lockSearchWithContext.Unlock()
+	return mock.SearchWithContextFunc(ctx, term)
+}
+
+// SearchWithContextCalls gets all the calls that were made to SearchWithContext.
+func (mock *ValidatorAPIMock) SearchWithContextCalls() []struct {
+	Ctx  context.Context
+	Term string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Term string
+	}
+	mock.lockSearchWithContext.RLock()
+	calls = mock.calls.SearchWithContext
+	mock.lockSearchWithContext.RUnlock()
+	return calls
+}
+
+// Activity calls ActivityFunc.
+func (mock *ValidatorAPIMock) Activity(input *helium.ValidatorActivityInput) (*helium.ValidatorActivity, error) {
+	if mock.ActivityFunc == nil {
+		panic("ValidatorAPIMock.ActivityFunc: method is nil but ValidatorAPI.Activity was just called")
+	}
+	callInfo := struct {
+		Input *helium.ValidatorActivityInput
+	}{
+		Input: input,
+	}
+	mock.lockActivity.Lock()
+	mock.calls.Activity = append(mock.calls.Activity, callInfo)
+	mock.lockActivity.Unlock()
+	return mock.ActivityFunc(input)
+}
+
+// ActivityCalls gets all the calls that were made to Activity.
+func (mock *ValidatorAPIMock) ActivityCalls() []struct {
+	Input *helium.ValidatorActivityInput
+} {
+	var calls []struct {
+		Input *helium.ValidatorActivityInput
+	}
+	mock.lockActivity.RLock()
+	calls = mock.calls.Activity
+	mock.lockActivity.RUnlock()
+	return calls
+}
+
+// ActivityWithContext calls ActivityWithContextFunc.
+func (mock *ValidatorAPIMock) ActivityWithContext(ctx context.Context, input *helium.ValidatorActivityInput) (*helium.ValidatorActivity, error) {
+	if mock.ActivityWithContextFunc == nil {
+		panic("ValidatorAPIMock.ActivityWithContextFunc: method is nil but ValidatorAPI.ActivityWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.ValidatorActivityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockActivityWithContext.Lock()
+	mock.calls.ActivityWithContext = append(mock.calls.ActivityWithContext, callInfo)
+	mock.lockActivityWithContext.Unlock()
+	return mock.ActivityWithContextFunc(ctx, input)
+}
+
+// ActivityWithContextCalls gets all the calls that were made to ActivityWithContext.
+func (mock *ValidatorAPIMock) ActivityWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.ValidatorActivityInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.ValidatorActivityInput
+	}
+	mock.lockActivityWithContext.RLock()
+	calls = mock.calls.ActivityWithContext
+	mock.lockActivityWithContext.RUnlock()
+	return calls
+}
+
+// ActivityAll calls ActivityAllFunc.
+func (mock *ValidatorAPIMock) ActivityAll(input *helium.ValidatorActivityInput, opts ...helium.PagerOption) *helium.ValidatorActivityPager {
+	if mock.ActivityAllFunc == nil {
+		panic("ValidatorAPIMock.ActivityAllFunc: method is nil but ValidatorAPI.ActivityAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.ValidatorActivityInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockActivityAll.Lock()
+	mock.calls.ActivityAll = append(mock.calls.ActivityAll, callInfo)
+	mock.lockActivityAll.Unlock()
+	return mock.ActivityAllFunc(input, opts...)
+}
+
+// ActivityAllCalls gets all the calls that were made to ActivityAll.
+func (mock *ValidatorAPIMock) ActivityAllCalls() []struct {
+	Input *helium.ValidatorActivityInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.ValidatorActivityInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockActivityAll.RLock()
+	calls = mock.calls.ActivityAll
+	mock.lockActivityAll.RUnlock()
+	return calls
+}
+
+// Roles calls RolesFunc.
+func (mock *ValidatorAPIMock) Roles(input *helium.ValidatorActivityInput) (*helium.Roles, error) {
+	if mock.RolesFunc == nil {
+		panic("ValidatorAPIMock.RolesFunc: method is nil but ValidatorAPI.Roles was just called")
+	}
+	callInfo := struct {
+		Input *helium.ValidatorActivityInput
+	}{
+		Input: input,
+	}
+	mock.lockRoles.Lock()
+	mock.calls.Roles = append(mock.calls.Roles, callInfo)
+	mock.lockRoles.Unlock()
+	return mock.RolesFunc(input)
+}
+
+// RolesCalls gets all the calls that were made to Roles.
+func (mock *ValidatorAPIMock) RolesCalls() []struct {
+	Input *helium.ValidatorActivityInput
+} {
+	var calls []struct {
+		Input *helium.ValidatorActivityInput
+	}
+	mock.lockRoles.RLock()
+	calls = mock.calls.Roles
+	mock.lockRoles.RUnlock()
+	return calls
+}
+
+// RolesWithContext calls RolesWithContextFunc.
+func (mock *ValidatorAPIMock) RolesWithContext(ctx context.Context, input *helium.ValidatorActivityInput) (*helium.Roles, error) {
+	if mock.RolesWithContextFunc == nil {
+		panic("ValidatorAPIMock.RolesWithContextFunc: method is nil but ValidatorAPI.RolesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.ValidatorActivityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRolesWithContext.Lock()
+	mock.calls.RolesWithContext = append(mock.calls.RolesWithContext, callInfo)
+	mock.lockRolesWithContext.Unlock()
+	return mock.RolesWithContextFunc(ctx, input)
+}
+
+// RolesWithContextCalls gets all the calls that were made to RolesWithContext.
+func (mock *ValidatorAPIMock) RolesWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.ValidatorActivityInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.ValidatorActivityInput
+	}
+	mock.lockRolesWithContext.RLock()
+	calls = mock.calls.RolesWithContext
+	mock.lockRolesWithContext.RUnlock()
+	return calls
+}
+
+// RolesAll calls RolesAllFunc.
+func (mock *ValidatorAPIMock) RolesAll(input *helium.ValidatorActivityInput, opts ...helium.PagerOption) *helium.RolePager {
+	if mock.RolesAllFunc == nil {
+		panic("ValidatorAPIMock.RolesAllFunc: method is nil but ValidatorAPI.RolesAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.ValidatorActivityInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockRolesAll.Lock()
+	mock.calls.RolesAll = append(mock.calls.RolesAll, callInfo)
+	mock.lockRolesAll.Unlock()
+	return mock.RolesAllFunc(input, opts...)
+}
+
+// RolesAllCalls gets all the calls that were made to RolesAll.
+func (mock *ValidatorAPIMock) RolesAllCalls() []struct {
+	Input *helium.ValidatorActivityInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.ValidatorActivityInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockRolesAll.RLock()
+	calls = mock.calls.RolesAll
+	mock.lockRolesAll.RUnlock()
+	return calls
+}
+
+// ActivityCount calls ActivityCountFunc.
+func (mock *ValidatorAPIMock) ActivityCount(address helium.Address, filterTypes []helium.TxnType) (*helium.ValidatorActivityCount, error) {
+	if mock.ActivityCountFunc == nil {
+		panic("ValidatorAPIMock.ActivityCountFunc: method is nil but ValidatorAPI.ActivityCount was just called")
+	}
+	callInfo := struct {
+		Address     helium.Address
+		FilterTypes []helium.TxnType
+	}{
+		Address:     address,
+		FilterTypes: filterTypes,
+	}
+	mock.lockActivityCount.Lock()
+	mock.calls.ActivityCount = append(mock.calls.ActivityCount, callInfo)
+	mock.lockActivityCount.Unlock()
+	return mock.ActivityCountFunc(address, filterTypes)
+}
+
+// ActivityCountCalls gets all the calls that were made to ActivityCount.
+func (mock *ValidatorAPIMock) ActivityCountCalls() []struct {
+	Address     helium.Address
+	FilterTypes []helium.TxnType
+} {
+	var calls []struct {
+		Address     helium.Address
+		FilterTypes []helium.TxnType
+	}
+	mock.lockActivityCount.RLock()
+	calls = mock.calls.ActivityCount
+	mock.lockActivityCount.RUnlock()
+	return calls
+}
+
+// ActivityCountWithContext calls ActivityCountWithContextFunc.
+func (mock *ValidatorAPIMock) ActivityCountWithContext(ctx context.Context, address helium.Address, filterTypes []helium.TxnType) (*helium.ValidatorActivityCount, error) {
+	if mock.ActivityCountWithContextFunc == nil {
+		panic("ValidatorAPIMock.ActivityCountWithContextFunc: method is nil but ValidatorAPI.ActivityCountWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Address     helium.Address
+		FilterTypes []helium.TxnType
+	}{
+		Ctx:         ctx,
+		Address:     address,
+		FilterTypes: filterTypes,
+	}
+	mock.lockActivityCountWithContext.Lock()
+	mock.calls.ActivityCountWithContext = append(mock.calls.ActivityCountWithContext, callInfo)
+	mock.lockActivityCountWithContext.Unlock()
+	return mock.ActivityCountWithContextFunc(ctx, address, filterTypes)
+}
+
+// ActivityCountWithContextCalls gets all the calls that were made to ActivityCountWithContext.
+func (mock *ValidatorAPIMock) ActivityCountWithContextCalls() []struct {
+	Ctx         context.Context
+	Address     helium.Address
+	FilterTypes []helium.TxnType
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Address     helium.Address
+		FilterTypes []helium.TxnType
+	}
+	mock.lockActivityCountWithContext.RLock()
+	calls = mock.calls.ActivityCountWithContext
+	mock.lockActivityCountWithContext.RUnlock()
+	return calls
+}
+
+// Stats calls StatsFunc.
+func (mock *ValidatorAPIMock) Stats() (*helium.ValidatorStats, error) {
+	if mock.StatsFunc == nil {
+		panic("ValidatorAPIMock.StatsFunc: method is nil but ValidatorAPI.Stats was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockStats.Lock()
+	mock.calls.Stats = append(mock.calls.Stats, callInfo)
+	mock.lockStats.Unlock()
+	return mock.StatsFunc()
+}
+
+// StatsCalls gets all the calls that were made to Stats.
+func (mock *ValidatorAPIMock) StatsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockStats.RLock()
+	calls = mock.calls.Stats
+	mock.lockStats.RUnlock()
+	return calls
+}
+
+// StatsWithContext calls StatsWithContextFunc.
+func (mock *ValidatorAPIMock) StatsWithContext(ctx context.Context) (*helium.ValidatorStats, error) {
+	if mock.StatsWithContextFunc == nil {
+		panic("ValidatorAPIMock.StatsWithContextFunc: method is nil but ValidatorAPI.StatsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockStatsWithContext.Lock()
+	mock.calls.StatsWithContext = append(mock.calls.StatsWithContext, callInfo)
+	mock.lockStatsWithContext.Unlock()
+	return mock.StatsWithContextFunc(ctx)
+}
+
+// StatsWithContextCalls gets all the calls that were made to StatsWithContext.
+func (mock *ValidatorAPIMock) StatsWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockStatsWithContext.RLock()
+	calls = mock.calls.StatsWithContext
+	mock.lockStatsWithContext.RUnlock()
+	return calls
+}
+
+// ListElected calls ListElectedFunc.
+func (mock *ValidatorAPIMock) ListElected() (*helium.ValidatorElections, error) {
+	if mock.ListElectedFunc == nil {
+		panic("ValidatorAPIMock.ListElectedFunc: method is nil but ValidatorAPI.ListElected was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListElected.Lock()
+	mock.calls.ListElected = append(mock.calls.ListElected, callInfo)
+	mock.lockListElected.Unlock()
+	return mock.ListElectedFunc()
+}
+
+// ListElectedCalls gets all the calls that were made to ListElected.
+func (mock *ValidatorAPIMock) ListElectedCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListElected.RLock()
+	calls = mock.calls.ListElected
+	mock.lockListElected.RUnlock()
+	return calls
+}
+
+// ListElectedWithContext calls ListElectedWithContextFunc.
+func (mock *ValidatorAPIMock) ListElectedWithContext(ctx context.Context) (*helium.ValidatorElections, error) {
+	if mock.ListElectedWithContextFunc == nil {
+		panic("ValidatorAPIMock.ListElectedWithContextFunc: method is nil but ValidatorAPI.ListElectedWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListElectedWithContext.Lock()
+	mock.calls.ListElectedWithContext = append(mock.calls.ListElectedWithContext, callInfo)
+	mock.lockListElectedWithContext.Unlock()
+	return mock.ListElectedWithContextFunc(ctx)
+}
+
+// ListElectedWithContextCalls gets all the calls that were made to ListElectedWithContext.
+func (mock *ValidatorAPIMock) ListElectedWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListElectedWithContext.RLock()
+	calls = mock.calls.ListElectedWithContext
+	mock.lockListElectedWithContext.RUnlock()
+	return calls
+}
+
+// ElectedAtHeight calls ElectedAtHeightFunc.
+func (mock *ValidatorAPIMock) ElectedAtHeight(height string) (*helium.Validators, error) {
+	if mock.ElectedAtHeightFunc == nil {
+		panic("ValidatorAPIMock.ElectedAtHeightFunc: method is nil but ValidatorAPI.ElectedAtHeight was just called")
+	}
+	callInfo := struct {
+		Height string
+	}{
+		Height: height,
+	}
+	mock.lockElectedAtHeight.Lock()
+	mock.calls.ElectedAtHeight = append(mock.calls.ElectedAtHeight, callInfo)
+	mock.lockElectedAtHeight.Unlock()
+	return mock.ElectedAtHeightFunc(height)
+}
+
+// ElectedAtHeightCalls gets all the calls that were made to ElectedAtHeight.
+func (mock *ValidatorAPIMock) ElectedAtHeightCalls() []struct {
+	Height string
+} {
+	var calls []struct {
+		Height string
+	}
+	mock.lockElectedAtHeight.RLock()
+	calls = mock.calls.ElectedAtHeight
+	mock.lockElectedAtHeight.RUnlock()
+	return calls
+}
+
+// ElectedAtHeightWithContext calls ElectedAtHeightWithContextFunc.
+func (mock *ValidatorAPIMock) ElectedAtHeightWithContext(ctx context.Context, height string) (*helium.Validators, error) {
+	if mock.ElectedAtHeightWithContextFunc == nil {
+		panic("ValidatorAPIMock.ElectedAtHeightWithContextFunc: method is nil but ValidatorAPI.ElectedAtHeightWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Height string
+	}{
+		Ctx:    ctx,
+		Height: height,
+	}
+	mock.lockElectedAtHeightWithContext.Lock()
+	mock.calls.ElectedAtHeightWithContext = append(mock.calls.ElectedAtHeightWithContext, callInfo)
+	mock.lockElectedAtHeightWithContext.Unlock()
+	return mock.ElectedAtHeightWithContextFunc(ctx, height)
+}
+
+// ElectedAtHeightWithContextCalls gets all the calls that were made to ElectedAtHeightWithContext.
+func (mock *ValidatorAPIMock) ElectedAtHeightWithContextCalls() []struct {
+	Ctx    context.Context
+	Height string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Height string
+	}
+	mock.lockElectedAtHeightWithContext.RLock()
+	calls = mock.calls.ElectedAtHeightWithContext
+	mock.lockElectedAtHeightWithContext.RUnlock()
+	return calls
+}
+
+// ElectedAtHash calls ElectedAtHashFunc.
+func (mock *ValidatorAPIMock) ElectedAtHash(hash string) (*helium.Validators, error) {
+	if mock.ElectedAtHashFunc == nil {
+		panic("ValidatorAPIMock.ElectedAtHashFunc: method is nil but ValidatorAPI.ElectedAtHash was just called")
+	}
+	callInfo := struct {
+		Hash string
+	}{
+		Hash: hash,
+	}
+	mock.lockElectedAtHash.Lock()
+	mock.calls.ElectedAtHash = append(mock.calls.ElectedAtHash, callInfo)
+	mock.lockElectedAtHash.Unlock()
+	return mock.ElectedAtHashFunc(hash)
+}
+
+// ElectedAtHashCalls gets all the calls that were made to ElectedAtHash.
+func (mock *ValidatorAPIMock) ElectedAtHashCalls() []struct {
+	Hash string
+} {
+	var calls []struct {
+		Hash string
+	}
+	mock.lockElectedAtHash.RLock()
+	calls = mock.calls.ElectedAtHash
+	mock.lockElectedAtHash.RUnlock()
+	return calls
+}
+
+// ElectedAtHashWithContext calls ElectedAtHashWithContextFunc.
+func (mock *ValidatorAPIMock) ElectedAtHashWithContext(ctx context.Context, hash string) (*helium.Validators, error) {
+	if mock.ElectedAtHashWithContextFunc == nil {
+		panic("ValidatorAPIMock.ElectedAtHashWithContextFunc: method is nil but ValidatorAPI.ElectedAtHashWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Hash string
+	}{
+		Ctx:  ctx,
+		Hash: hash,
+	}
+	mock.lockElectedAtHashWithContext.Lock()
+	mock.calls.ElectedAtHashWithContext = append(mock.calls.ElectedAtHashWithContext, callInfo)
+	mock.lockElectedAtHashWithContext.Unlock()
+	return mock.ElectedAtHashWithContextFunc(ctx, hash)
+}
+
+// ElectedAtHashWithContextCalls gets all the calls that were made to ElectedAtHashWithContext.
+func (mock *ValidatorAPIMock) ElectedAtHashWithContextCalls() []struct {
+	Ctx  context.Context
+	Hash string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Hash string
+	}
+	mock.lockElectedAtHashWithContext.RLock()
+	calls = mock.calls.ElectedAtHashWithContext
+	mock.lockElectedAtHashWithContext.RUnlock()
+	return calls
+}
+
+// Rewards calls RewardsFunc.
+func (mock *ValidatorAPIMock) Rewards(address helium.Address, cursor string, maxTime helium.TimeBound, minTime helium.TimeBound) (*helium.ValidatorRewards, error) {
+	if mock.RewardsFunc == nil {
+		panic("ValidatorAPIMock.RewardsFunc: method is nil but ValidatorAPI.Rewards was just called")
+	}
+	callInfo := struct {
+		Address helium.Address
+		Cursor  string
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+	}{
+		Address: address,
+		Cursor:  cursor,
+		MaxTime: maxTime,
+		MinTime: minTime,
+	}
+	mock.lockRewards.Lock()
+	mock.calls.Rewards = append(mock.calls.Rewards, callInfo)
+	mock.lockRewards.Unlock()
+	return mock.RewardsFunc(address, cursor, maxTime, minTime)
+}
+
+// RewardsCalls gets all the calls that were made to Rewards.
+func (mock *ValidatorAPIMock) RewardsCalls() []struct {
+	Address helium.Address
+	Cursor  string
+	MaxTime helium.TimeBound
+	MinTime helium.TimeBound
+} {
+	var calls []struct {
+		Address helium.Address
+		Cursor  string
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+	}
+	mock.lockRewards.RLock()
+	calls = mock.calls.Rewards
+	mock.lockRewards.RUnlock()
+	return calls
+}
+
+// RewardsWithContext calls RewardsWithContextFunc.
+func (mock *ValidatorAPIMock) RewardsWithContext(ctx context.Context, address helium.Address, cursor string, maxTime helium.TimeBound, minTime helium.TimeBound) (*helium.ValidatorRewards, error) {
+	if mock.RewardsWithContextFunc == nil {
+		panic("ValidatorAPIMock.RewardsWithContextFunc: method is nil but ValidatorAPI.RewardsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Address helium.Address
+		Cursor  string
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+	}{
+		Ctx:     ctx,
+		Address: address,
+		Cursor:  cursor,
+		MaxTime: maxTime,
+		MinTime: minTime,
+	}
+	mock.lockRewardsWithContext.Lock()
+	mock.calls.RewardsWithContext = append(mock.calls.RewardsWithContext, callInfo)
+	mock.lockRewardsWithContext.Unlock()
+	return mock.RewardsWithContextFunc(ctx, address, cursor, maxTime, minTime)
+}
+
+// RewardsWithContextCalls gets all the calls that were made to RewardsWithContext.
+func (mock *ValidatorAPIMock) RewardsWithContextCalls() []struct {
+	Ctx     context.Context
+	Address helium.Address
+	Cursor  string
+	MaxTime helium.TimeBound
+	MinTime helium.TimeBound
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Address helium.Address
+		Cursor  string
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+	}
+	mock.lockRewardsWithContext.RLock()
+	calls = mock.calls.RewardsWithContext
+	mock.lockRewardsWithContext.RUnlock()
+	return calls
+}
+
+// RewardsAll calls RewardsAllFunc.
+func (mock *ValidatorAPIMock) RewardsAll(address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound, opts ...helium.PagerOption) *helium.ValidatorRewardPager {
+	if mock.RewardsAllFunc == nil {
+		panic("ValidatorAPIMock.RewardsAllFunc: method is nil but ValidatorAPI.RewardsAll was just called")
+	}
+	callInfo := struct {
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+		Opts    []helium.PagerOption
+	}{
+		Address: address,
+		MaxTime: maxTime,
+		MinTime: minTime,
+		Opts:    opts,
+	}
+	mock.lockRewardsAll.Lock()
+	mock.calls.RewardsAll = append(mock.calls.RewardsAll, callInfo)
+	mock.lockRewardsAll.Unlock()
+	return mock.RewardsAllFunc(address, maxTime, minTime, opts...)
+}
+
+// RewardsAllCalls gets all the calls that were made to RewardsAll.
+func (mock *ValidatorAPIMock) RewardsAllCalls() []struct {
+	Address helium.Address
+	MaxTime helium.TimeBound
+	MinTime helium.TimeBound
+	Opts    []helium.PagerOption
+} {
+	var calls []struct {
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+		Opts    []helium.PagerOption
+	}
+	mock.lockRewardsAll.RLock()
+	calls = mock.calls.RewardsAll
+	mock.lockRewardsAll.RUnlock()
+	return calls
+}
+
+// RewardsSum calls RewardsSumFunc.
+func (mock *ValidatorAPIMock) RewardsSum(address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound) (*helium.ValidatorRewardsSum, error) {
+	if mock.RewardsSumFunc == nil {
+		panic("ValidatorAPIMock.RewardsSumFunc: method is nil but ValidatorAPI.RewardsSum was just called")
+	}
+	callInfo := struct {
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+	}{
+		Address: address,
+		MaxTime: maxTime,
+		MinTime: minTime,
+	}
+	mock.lockRewardsSum.Lock()
+	mock.calls.RewardsSum = append(mock.calls.RewardsSum, callInfo)
+	mock.lockRewardsSum.Unlock()
+	return mock.RewardsSumFunc(address, maxTime, minTime)
+}
+
+// RewardsSumCalls gets all the calls that were made to RewardsSum.
+func (mock *ValidatorAPIMock) RewardsSumCalls() []struct {
+	Address helium.Address
+	MaxTime helium.TimeBound
+	MinTime helium.TimeBound
+} {
+	var calls []struct {
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+	}
+	mock.lockRewardsSum.RLock()
+	calls = mock.calls.RewardsSum
+	mock.lockRewardsSum.RUnlock()
+	return calls
+}
+
+// RewardsSumWithContext calls RewardsSumWithContextFunc.
+func (mock *ValidatorAPIMock) RewardsSumWithContext(ctx context.Context, address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound) (*helium.ValidatorRewardsSum, error) {
+	if mock.RewardsSumWithContextFunc == nil {
+		panic("ValidatorAPIMock.RewardsSumWithContextFunc: method is nil but ValidatorAPI.RewardsSumWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+	}{
+		Ctx:     ctx,
+		Address: address,
+		MaxTime: maxTime,
+		MinTime: minTime,
+	}
+	mock.lockRewardsSumWithContext.Lock()
+	mock.calls.RewardsSumWithContext = append(mock.calls.RewardsSumWithContext, callInfo)
+	mock.lockRewardsSumWithContext.Unlock()
+	return mock.RewardsSumWithContextFunc(ctx, address, maxTime, minTime)
+}
+
+// RewardsSumWithContextCalls gets all the calls that were made to RewardsSumWithContext.
+func (mock *ValidatorAPIMock) RewardsSumWithContextCalls() []struct {
+	Ctx     context.Context
+	Address helium.Address
+	MaxTime helium.TimeBound
+	MinTime helium.TimeBound
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+	}
+	mock.lockRewardsSumWithContext.RLock()
+	calls = mock.calls.RewardsSumWithContext
+	mock.lockRewardsSumWithContext.RUnlock()
+	return calls
+}
+
+// RewardsSumBuckets calls RewardsSumBucketsFunc.
+func (mock *ValidatorAPIMock) RewardsSumBuckets(address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound, bucket helium.Bucket) (*helium.RewardBuckets, error) {
+	if mock.RewardsSumBucketsFunc == nil {
+		panic("ValidatorAPIMock.RewardsSumBucketsFunc: method is nil but ValidatorAPI.RewardsSumBuckets was just called")
+	}
+	callInfo := struct {
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+		Bucket  helium.Bucket
+	}{
+		Address: address,
+		MaxTime: maxTime,
+		MinTime: minTime,
+		Bucket:  bucket,
+	}
+	mock.lockRewardsSumBuckets.Lock()
+	mock.calls.RewardsSumBuckets = append(mock.calls.RewardsSumBuckets, callInfo)
+	mock.lockRewardsSumBuckets.Unlock()
+	return mock.RewardsSumBucketsFunc(address, maxTime, minTime, bucket)
+}
+
+// RewardsSumBucketsCalls gets all the calls that were made to RewardsSumBuckets.
+func (mock *ValidatorAPIMock) RewardsSumBucketsCalls() []struct {
+	Address helium.Address
+	MaxTime helium.TimeBound
+	MinTime helium.TimeBound
+	Bucket  helium.Bucket
+} {
+	var calls []struct {
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+		Bucket  helium.Bucket
+	}
+	mock.lockRewardsSumBuckets.RLock()
+	calls = mock.calls.RewardsSumBuckets
+	mock.lockRewardsSumBuckets.RUnlock()
+	return calls
+}
+
+// RewardsSumBucketsWithContext calls RewardsSumBucketsWithContextFunc.
+func (mock *ValidatorAPIMock) RewardsSumBucketsWithContext(ctx context.Context, address helium.Address, maxTime helium.TimeBound, minTime helium.TimeBound, bucket helium.Bucket) (*helium.RewardBuckets, error) {
+	if mock.RewardsSumBucketsWithContextFunc == nil {
+		panic("ValidatorAPIMock.RewardsSumBucketsWithContextFunc: method is nil but ValidatorAPI.RewardsSumBucketsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+		Bucket  helium.Bucket
+	}{
+		Ctx:     ctx,
+		Address: address,
+		MaxTime: maxTime,
+		MinTime: minTime,
+		Bucket:  bucket,
+	}
+	mock.lockRewardsSumBucketsWithContext.Lock()
+	mock.calls.RewardsSumBucketsWithContext = append(mock.calls.RewardsSumBucketsWithContext, callInfo)
+	mock.lockRewardsSumBucketsWithContext.Unlock()
+	return mock.RewardsSumBucketsWithContextFunc(ctx, address, maxTime, minTime, bucket)
+}
+
+// RewardsSumBucketsWithContextCalls gets all the calls that were made to RewardsSumBucketsWithContext.
+func (mock *ValidatorAPIMock) RewardsSumBucketsWithContextCalls() []struct {
+	Ctx     context.Context
+	Address helium.Address
+	MaxTime helium.TimeBound
+	MinTime helium.TimeBound
+	Bucket  helium.Bucket
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Address helium.Address
+		MaxTime helium.TimeBound
+		MinTime helium.TimeBound
+		Bucket  helium.Bucket
+	}
+	mock.lockRewardsSumBucketsWithContext.RLock()
+	calls = mock.calls.RewardsSumBucketsWithContext
+	mock.lockRewardsSumBucketsWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that OracleAPIMock does implement helium.OracleAPI.
+var _ helium.OracleAPI = &OracleAPIMock{}
+
+// OracleAPIMock is a mock implementation of helium.OracleAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type OracleAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.OraclePriceListInput) (*helium.OraclePrices, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.OraclePriceListInput) (*helium.OraclePrices, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(opts ...helium.PagerOption) *helium.OraclePricePager
+
+	// CurrentFunc mocks the Current method.
+	CurrentFunc func() (*helium.OraclePrice, error)
+
+	// CurrentWithContextFunc mocks the CurrentWithContext method.
+	CurrentWithContextFunc func(ctx context.Context) (*helium.OraclePrice, error)
+
+	// StatsFunc mocks the Stats method.
+	StatsFunc func(input *helium.OraclePriceStatsInput) (*helium.OraclePriceStats, error)
+
+	// StatsWithContextFunc mocks the StatsWithContext method.
+	StatsWithContextFunc func(ctx context.Context, input *helium.OraclePriceStatsInput) (*helium.OraclePriceStats, error)
+
+	// BlockFunc mocks the Block method.
+	BlockFunc func(input *helium.OraclePriceBlockInput) (*helium.OraclePrice, error)
+
+	// BlockWithContextFunc mocks the BlockWithContext method.
+	BlockWithContextFunc func(ctx context.Context, input *helium.OraclePriceBlockInput) (*helium.OraclePrice, error)
+
+	// ActivityFunc mocks the Activity method.
+	ActivityFunc func(input *helium.OraclePriceActivityInput) (*helium.OraclePriceActivity, error)
+
+	// ActivityWithContextFunc mocks the ActivityWithContext method.
+	ActivityWithContextFunc func(ctx context.Context, input *helium.OraclePriceActivityInput) (*helium.OraclePriceActivity, error)
+
+	// ActivityAllFunc mocks the ActivityAll method.
+	ActivityAllFunc func(opts ...helium.PagerOption) *helium.OraclePriceActivityPager
+
+	calls struct {
+		List []struct {
+			Input *helium.OraclePriceListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.OraclePriceListInput
+		}
+		ListAll []struct {
+			Opts []helium.PagerOption
+		}
+		Current []struct {
+		}
+		CurrentWithContext []struct {
+			Ctx context.Context
+		}
+		Stats []struct {
+			Input *helium.OraclePriceStatsInput
+		}
+		StatsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.OraclePriceStatsInput
+		}
+		Block []struct {
+			Input *helium.OraclePriceBlockInput
+		}
+		BlockWithContext []struct {
+			Ctx   context.Context
+			Input *helium.OraclePriceBlockInput
+		}
+		Activity []struct {
+			Input *helium.OraclePriceActivityInput
+		}
+		ActivityWithContext []struct {
+			Ctx   context.Context
+			Input *helium.OraclePriceActivityInput
+		}
+		ActivityAll []struct {
+			Opts []helium.PagerOption
+		}
+	}
+	lockList                sync.RWMutex
+	lockListWithContext     sync.RWMutex
+	lockListAll             sync.RWMutex
+	lockCurrent             sync.RWMutex
+	lockCurrentWithContext  sync.RWMutex
+	lockStats               sync.RWMutex
+	lockStatsWithContext    sync.RWMutex
+	lockBlock               sync.RWMutex
+	lockBlockWithContext    sync.RWMutex
+	lockActivity            sync.RWMutex
+	lockActivityWithContext sync.RWMutex
+	lockActivityAll         sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *OracleAPIMock) List(input *helium.OraclePriceListInput) (*helium.OraclePrices, error) {
+	if mock.ListFunc == nil {
+		panic("OracleAPIMock.ListFunc: method is nil but OracleAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.OraclePriceListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *OracleAPIMock) ListCalls() []struct {
+	Input *helium.OraclePriceListInput
+} {
+	var calls []struct {
+		Input *helium.OraclePriceListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *OracleAPIMock) ListWithContext(ctx context.Context, input *helium.OraclePriceListInput) (*helium.OraclePrices, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("OracleAPIMock.ListWithContextFunc: method is nil but OracleAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.OraclePriceListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *OracleAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.OraclePriceListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.OraclePriceListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *OracleAPIMock) ListAll(opts ...helium.PagerOption) *helium.OraclePricePager {
+	if mock.ListAllFunc == nil {
+		panic("OracleAPIMock.ListAllFunc: method is nil but OracleAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Opts []helium.PagerOption
+	}{
+		Opts: opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *OracleAPIMock) ListAllCalls() []struct {
+	Opts []helium.PagerOption
+} {
+	var calls []struct {
+		Opts []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Current calls CurrentFunc.
+func (mock *OracleAPIMock) Current() (*helium.OraclePrice, error) {
+	if mock.CurrentFunc == nil {
+		panic("OracleAPIMock.CurrentFunc: method is nil but OracleAPI.Current was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockCurrent.Lock()
+	mock.calls.Current = append(mock.calls.Current, callInfo)
+	mock.lockCurrent.Unlock()
+	return mock.CurrentFunc()
+}
+
+// CurrentCalls gets all the calls that were made to Current.
+func (mock *OracleAPIMock) CurrentCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockCurrent.RLock()
+	calls = mock.calls.Current
+	mock.lockCurrent.RUnlock()
+	return calls
+}
+
+// CurrentWithContext calls CurrentWithContextFunc.
+func (mock *OracleAPIMock) CurrentWithContext(ctx context.Context) (*helium.OraclePrice, error) {
+	if mock.CurrentWithContextFunc == nil {
+		panic("OracleAPIMock.CurrentWithContextFunc: method is nil but OracleAPI.CurrentWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCurrentWithContext.Lock()
+	mock.calls.CurrentWithContext = append(mock.calls.CurrentWithContext, callInfo)
+	mock.lockCurrentWithContext.Unlock()
+	return mock.CurrentWithContextFunc(ctx)
+}
+
+// CurrentWithContextCalls gets all the calls that were made to CurrentWithContext.
+func (mock *OracleAPIMock) CurrentWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCurrentWithContext.RLock()
+	calls = mock.calls.CurrentWithContext
+	mock.lockCurrentWithContext.RUnlock()
+	return calls
+}
+
+// Stats calls StatsFunc.
+func (mock *OracleAPIMock) Stats(input *helium.OraclePriceStatsInput) (*helium.OraclePriceStats, error) {
+	if mock.StatsFunc == nil {
+		panic("OracleAPIMock.StatsFunc: method is nil but OracleAPI.Stats was just called")
+	}
+	callInfo := struct {
+		Input *helium.OraclePriceStatsInput
+	}{
+		Input: input,
+	}
+	mock.lockStats.Lock()
+	mock.calls.Stats = append(mock.calls.Stats, callInfo)
+	mock.lockStats.Unlock()
+	return mock.StatsFunc(input)
+}
+
+// StatsCalls gets all the calls that were made to Stats.
+func (mock *OracleAPIMock) StatsCalls() []struct {
+	Input *helium.OraclePriceStatsInput
+} {
+	var calls []struct {
+		Input *helium.OraclePriceStatsInput
+	}
+	mock.lockStats.RLock()
+	calls = mock.calls.Stats
+	mock.lockStats.RUnlock()
+	return calls
+}
+
+// StatsWithContext calls StatsWithContextFunc.
+func (mock *OracleAPIMock) StatsWithContext(ctx context.Context, input *helium.OraclePriceStatsInput) (*helium.OraclePriceStats, error) {
+	if mock.StatsWithContextFunc == nil {
+		panic("OracleAPIMock.StatsWithContextFunc: method is nil but OracleAPI.StatsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.OraclePriceStatsInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockStatsWithContext.Lock()
+	mock.calls.StatsWithContext = append(mock.calls.StatsWithContext, callInfo)
+	mock.lockStatsWithContext.Unlock()
+	return mock.StatsWithContextFunc(ctx, input)
+}
+
+// StatsWithContextCalls gets all the calls that were made to StatsWithContext.
+func (mock *OracleAPIMock) StatsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.OraclePriceStatsInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.OraclePriceStatsInput
+	}
+	mock.lockStatsWithContext.RLock()
+	calls = mock.calls.StatsWithContext
+	mock.lockStatsWithContext.RUnlock()
+	return calls
+}
+
+// Block calls BlockFunc.
+func (mock *OracleAPIMock) Block(input *helium.OraclePriceBlockInput) (*helium.OraclePrice, error) {
+	if mock.BlockFunc == nil {
+		panic("OracleAPIMock.BlockFunc: method is nil but OracleAPI.Block was just called")
+	}
+	callInfo := struct {
+		Input *helium.OraclePriceBlockInput
+	}{
+		Input: input,
+	}
+	mock.lockBlock.Lock()
+	mock.calls.Block = append(mock.calls.Block, callInfo)
+	mock.lockBlock.Unlock()
+	return mock.BlockFunc(input)
+}
+
+// BlockCalls gets all the calls that were made to Block.
+func (mock *OracleAPIMock) BlockCalls() []struct {
+	Input *helium.OraclePriceBlockInput
+} {
+	var calls []struct {
+		Input *helium.OraclePriceBlockInput
+	}
+	mock.lockBlock.RLock()
+	calls = mock.calls.Block
+	mock.lockBlock.RUnlock()
+	return calls
+}
+
+// BlockWithContext calls BlockWithContextFunc.
+func (mock *OracleAPIMock) BlockWithContext(ctx context.Context, input *helium.OraclePriceBlockInput) (*helium.OraclePrice, error) {
+	if mock.BlockWithContextFunc == nil {
+		panic("OracleAPIMock.BlockWithContextFunc: method is nil but OracleAPI.BlockWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.OraclePriceBlockInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockBlockWithContext.Lock()
+	mock.calls.BlockWithContext = append(mock.calls.BlockWithContext, callInfo)
+	mock.lockBlockWithContext.Unlock()
+	return mock.BlockWithContextFunc(ctx, input)
+}
+
+// BlockWithContextCalls gets all the calls that were made to BlockWithContext.
+func (mock *OracleAPIMock) BlockWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.OraclePriceBlockInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.OraclePriceBlockInput
+	}
+	mock.lockBlockWithContext.RLock()
+	calls = mock.calls.BlockWithContext
+	mock.lockBlockWithContext.RUnlock()
+	return calls
+}
+
+// Activity calls ActivityFunc.
+func (mock *OracleAPIMock) Activity(input *helium.OraclePriceActivityInput) (*helium.OraclePriceActivity, error) {
+	if mock.ActivityFunc == nil {
+		panic("OracleAPIMock.ActivityFunc: method is nil but OracleAPI.Activity was just called")
+	}
+	callInfo := struct {
+		Input *helium.OraclePriceActivityInput
+	}{
+		Input: input,
+	}
+	mock.lockActivity.Lock()
+	mock.calls.Activity = append(mock.calls.Activity, callInfo)
+	mock.lockActivity.Unlock()
+	return mock.ActivityFunc(input)
+}
+
+// ActivityCalls gets all the calls that were made to Activity.
+func (mock *OracleAPIMock) ActivityCalls() []struct {
+	Input *helium.OraclePriceActivityInput
+} {
+	var calls []struct {
+		Input *helium.OraclePriceActivityInput
+	}
+	mock.lockActivity.RLock()
+	calls = mock.calls.Activity
+	mock.lockActivity.RUnlock()
+	return calls
+}
+
+// ActivityWithContext calls ActivityWithContextFunc.
+func (mock *OracleAPIMock) ActivityWithContext(ctx context.Context, input *helium.OraclePriceActivityInput) (*helium.OraclePriceActivity, error) {
+	if mock.ActivityWithContextFunc == nil {
+		panic("OracleAPIMock.ActivityWithContextFunc: method is nil but OracleAPI.ActivityWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.OraclePriceActivityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockActivityWithContext.Lock()
+	mock.calls.ActivityWithContext = append(mock.calls.ActivityWithContext, callInfo)
+	mock.lockActivityWithContext.Unlock()
+	return mock.ActivityWithContextFunc(ctx, input)
+}
+
+// ActivityWithContextCalls gets all the calls that were made to ActivityWithContext.
+func (mock *OracleAPIMock) ActivityWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.OraclePriceActivityInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.OraclePriceActivityInput
+	}
+	mock.lockActivityWithContext.RLock()
+	calls = mock.calls.ActivityWithContext
+	mock.lockActivityWithContext.RUnlock()
+	return calls
+}
+
+// ActivityAll calls ActivityAllFunc.
+func (mock *OracleAPIMock) ActivityAll(opts ...helium.PagerOption) *helium.OraclePriceActivityPager {
+	if mock.ActivityAllFunc == nil {
+		panic("OracleAPIMock.ActivityAllFunc: method is nil but OracleAPI.ActivityAll was just called")
+	}
+	callInfo := struct {
+		Opts []helium.PagerOption
+	}{
+		Opts: opts,
+	}
+	mock.lockActivityAll.Lock()
+	mock.calls.ActivityAll = append(mock.calls.ActivityAll, callInfo)
+	mock.lockActivityAll.Unlock()
+	return mock.ActivityAllFunc(opts...)
+}
+
+// ActivityAllCalls gets all the calls that were made to ActivityAll.
+func (mock *OracleAPIMock) ActivityAllCalls() []struct {
+	Opts []helium.PagerOption
+} {
+	var calls []struct {
+		Opts []helium.PagerOption
+	}
+	mock.lockActivityAll.RLock()
+	calls = mock.calls.ActivityAll
+	mock.lockActivityAll.RUnlock()
+	return calls
+}
+
+// Ensure, that CityAPIMock does implement helium.CityAPI.
+var _ helium.CityAPI = &CityAPIMock{}
+
+// CityAPIMock is a mock implementation of helium.CityAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type CityAPIMock struct {
+	// SearchFunc mocks the Search method.
+	SearchFunc func(input *helium.CitySearchInput) (*helium.Cities, error)
+
+	// SearchWithContextFunc mocks the SearchWithContext method.
+	SearchWithContextFunc func(ctx context.Context, input *helium.CitySearchInput) (*helium.Cities, error)
+
+	// SearchAllFunc mocks the SearchAll method.
+	SearchAllFunc func(input *helium.CitySearchInput, opts ...helium.PagerOption) *helium.CityPager
+
+	// HotspotsFunc mocks the Hotspots method.
+	HotspotsFunc func(input *helium.CityInput) (*helium.Hotspots, error)
+
+	// HotspotsWithContextFunc mocks the HotspotsWithContext method.
+	HotspotsWithContextFunc func(ctx context.Context, input *helium.CityInput) (*helium.Hotspots, error)
+
+	// HotspotsAllFunc mocks the HotspotsAll method.
+	HotspotsAllFunc func(input *helium.CityInput, opts ...helium.PagerOption) *helium.HotspotPager
+
+	calls struct {
+		Search []struct {
+			Input *helium.CitySearchInput
+		}
+		SearchWithContext []struct {
+			Ctx   context.Context
+			Input *helium.CitySearchInput
+		}
+		SearchAll []struct {
+			Input *helium.CitySearchInput
+			Opts  []helium.PagerOption
+		}
+		Hotspots []struct {
+			Input *helium.CityInput
+		}
+		HotspotsWithContext []struct {
+			Ctx   context.Context
+			Input *helium.CityInput
+		}
+		HotspotsAll []struct {
+			Input *helium.CityInput
+			Opts  []helium.PagerOption
+		}
+	}
+	lockSearch              sync.RWMutex
+	lockSearchWithContext   sync.RWMutex
+	lockSearchAll           sync.RWMutex
+	lockHotspots            sync.RWMutex
+	lockHotspotsWithContext sync.RWMutex
+	lockHotspotsAll         sync.RWMutex
+}
+
+// Search calls SearchFunc.
+func (mock *CityAPIMock) Search(input *helium.CitySearchInput) (*helium.Cities, error) {
+	if mock.SearchFunc == nil {
+		panic("CityAPIMock.SearchFunc: method is nil but CityAPI.Search was just called")
+	}
+	callInfo := struct {
+		Input *helium.CitySearchInput
+	}{
+		Input: input,
+	}
+	mock.lockSearch.Lock()
+	mock.calls.Search = append(mock.calls.Search, callInfo)
+	mock.lockSearch.Unlock()
+	return mock.SearchFunc(input)
+}
+
+// SearchCalls gets all the calls that were made to Search.
+func (mock *CityAPIMock) SearchCalls() []struct {
+	Input *helium.CitySearchInput
+} {
+	var calls []struct {
+		Input *helium.CitySearchInput
+	}
+	mock.lockSearch.RLock()
+	calls = mock.calls.Search
+	mock.lockSearch.RUnlock()
+	return calls
+}
+
+// SearchWithContext calls SearchWithContextFunc.
+func (mock *CityAPIMock) SearchWithContext(ctx context.Context, input *helium.CitySearchInput) (*helium.Cities, error) {
+	if mock.SearchWithContextFunc == nil {
+		panic("CityAPIMock.SearchWithContextFunc: method is nil but CityAPI.SearchWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.CitySearchInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockSearchWithContext.Lock()
+	mock.calls.SearchWithContext = append(mock.calls.SearchWithContext, callInfo)
+	mock.lockSearchWithContext.Unlock()
+	return mock.SearchWithContextFunc(ctx, input)
+}
+
+// SearchWithContextCalls gets all the calls that were made to SearchWithContext.
+func (mock *CityAPIMock) SearchWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.CitySearchInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.CitySearchInput
+	}
+	mock.lockSearchWithContext.RLock()
+	calls = mock.calls.SearchWithContext
+	mock.lockSearchWithContext.RUnlock()
+	return calls
+}
+
+// SearchAll calls SearchAllFunc.
+func (mock *CityAPIMock) SearchAll(input *helium.CitySearchInput, opts ...helium.PagerOption) *helium.CityPager {
+	if mock.SearchAllFunc == nil {
+		panic("CityAPIMock.SearchAllFunc: method is nil but CityAPI.SearchAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.CitySearchInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockSearchAll.Lock()
+	mock.calls.SearchAll = append(mock.calls.SearchAll, callInfo)
+	mock.lockSearchAll.Unlock()
+	return mock.SearchAllFunc(input, opts...)
+}
+
+// SearchAllCalls gets all the calls that were made to SearchAll.
+func (mock *CityAPIMock) SearchAllCalls() []struct {
+	Input *helium.CitySearchInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.CitySearchInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockSearchAll.RLock()
+	calls = mock.calls.SearchAll
+	mock.lockSearchAll.RUnlock()
+	return calls
+}
+
+// Hotspots calls HotspotsFunc.
+func (mock *CityAPIMock) Hotspots(input *helium.CityInput) (*helium.Hotspots, error) {
+	if mock.HotspotsFunc == nil {
+		panic("CityAPIMock.HotspotsFunc: method is nil but CityAPI.Hotspots was just called")
+	}
+	callInfo := struct {
+		Input *helium.CityInput
+	}{
+		Input: input,
+	}
+	mock.lockHotspots.Lock()
+	mock.calls.Hotspots = append(mock.calls.Hotspots, callInfo)
+	mock.lockHotspots.Unlock()
+	return mock.HotspotsFunc(input)
+}
+
+// HotspotsCalls gets all the calls that were made to Hotspots.
+func (mock *CityAPIMock) HotspotsCalls() []struct {
+	Input *helium.CityInput
+} {
+	var calls []struct {
+		Input *helium.CityInput
+	}
+	mock.lockHotspots.RLock()
+	calls = mock.calls.Hotspots
+	mock.lockHotspots.RUnlock()
+	return calls
+}
+
+// HotspotsWithContext calls HotspotsWithContextFunc.
+func (mock *CityAPIMock) HotspotsWithContext(ctx context.Context, input *helium.CityInput) (*helium.Hotspots, error) {
+	if mock.HotspotsWithContextFunc == nil {
+		panic("CityAPIMock.HotspotsWithContextFunc: method is nil but CityAPI.HotspotsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.CityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockHotspotsWithContext.Lock()
+	mock.calls.HotspotsWithContext = append(mock.calls.HotspotsWithContext, callInfo)
+	mock.lockHotspotsWithContext.Unlock()
+	return mock.HotspotsWithContextFunc(ctx, input)
+}
+
+// HotspotsWithContextCalls gets all the calls that were made to HotspotsWithContext.
+func (mock *CityAPIMock) HotspotsWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.CityInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.CityInput
+	}
+	mock.lockHotspotsWithContext.RLock()
+	calls = mock.calls.HotspotsWithContext
+	mock.lockHotspotsWithContext.RUnlock()
+	return calls
+}
+
+// HotspotsAll calls HotspotsAllFunc.
+func (mock *CityAPIMock) HotspotsAll(input *helium.CityInput, opts ...helium.PagerOption) *helium.HotspotPager {
+	if mock.HotspotsAllFunc == nil {
+		panic("CityAPIMock.HotspotsAllFunc: method is nil but CityAPI.HotspotsAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.CityInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockHotspotsAll.Lock()
+	mock.calls.HotspotsAll = append(mock.calls.HotspotsAll, callInfo)
+	mock.lockHotspotsAll.Unlock()
+	return mock.HotspotsAllFunc(input, opts...)
+}
+
+// HotspotsAllCalls gets all the calls that were made to HotspotsAll.
+func (mock *CityAPIMock) HotspotsAllCalls() []struct {
+	Input *helium.CityInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.CityInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockHotspotsAll.RLock()
+	calls = mock.calls.HotspotsAll
+	mock.lockHotspotsAll.RUnlock()
+	return calls
+}
+
+// Ensure, that LocationAPIMock does implement helium.LocationAPI.
+var _ helium.LocationAPI = &LocationAPIMock{}
+
+// LocationAPIMock is a mock implementation of helium.LocationAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type LocationAPIMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(input *helium.LocationInput) (*helium.LocationInfo, error)
+
+	// GetWithContextFunc mocks the GetWithContext method.
+	GetWithContextFunc func(ctx context.Context, input *helium.LocationInput) (*helium.LocationInfo, error)
+
+	calls struct {
+		Get []struct {
+			Input *helium.LocationInput
+		}
+		GetWithContext []struct {
+			Ctx   context.Context
+			Input *helium.LocationInput
+		}
+	}
+	lockGet            sync.RWMutex
+	lockGetWithContext sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *LocationAPIMock) Get(input *helium.LocationInput) (*helium.LocationInfo, error) {
+	if mock.GetFunc == nil {
+		panic("LocationAPIMock.GetFunc: method is nil but LocationAPI.Get was just called")
+	}
+	callInfo := struct {
+		Input *helium.LocationInput
+	}{
+		Input: input,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(input)
+}
+
+// GetCalls gets all the calls that were made to Get.
+func (mock *LocationAPIMock) GetCalls() []struct {
+	Input *helium.LocationInput
+} {
+	var calls []struct {
+		Input *helium.LocationInput
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (mock *LocationAPIMock) GetWithContext(ctx context.Context, input *helium.LocationInput) (*helium.LocationInfo, error) {
+	if mock.GetWithContextFunc == nil {
+		panic("LocationAPIMock.GetWithContextFunc: method is nil but LocationAPI.GetWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.LocationInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockGetWithContext.Lock()
+	mock.calls.GetWithContext = append(mock.calls.GetWithContext, callInfo)
+	mock.lockGetWithContext.Unlock()
+	return mock.GetWithContextFunc(ctx, input)
+}
+
+// GetWithContextCalls gets all the calls that were made to GetWithContext.
+func (mock *LocationAPIMock) GetWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.LocationInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.LocationInput
+	}
+	mock.lockGetWithContext.RLock()
+	calls = mock.calls.GetWithContext
+	mock.lockGetWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that StatAPIMock does implement helium.StatAPI.
+var _ helium.StatAPI = &StatAPIMock{}
+
+// StatAPIMock is a mock implementation of helium.StatAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type StatAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func() (*helium.Stats, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context) (*helium.Stats, error)
+
+	// TokenSupplyFunc mocks the TokenSupply method.
+	TokenSupplyFunc func() (*helium.TokenSupply, error)
+
+	// TokenSupplyWithContextFunc mocks the TokenSupplyWithContext method.
+	TokenSupplyWithContextFunc func(ctx context.Context) (*helium.TokenSupply, error)
+
+	calls struct {
+		List []struct {
+		}
+		ListWithContext []struct {
+			Ctx context.Context
+		}
+		TokenSupply []struct {
+		}
+		TokenSupplyWithContext []struct {
+			Ctx context.Context
+		}
+	}
+	lockList                   sync.RWMutex
+	lockListWithContext        sync.RWMutex
+	lockTokenSupply            sync.RWMutex
+	lockTokenSupplyWithContext sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *StatAPIMock) List() (*helium.Stats, error) {
+	if mock.ListFunc == nil {
+		panic("StatAPIMock.ListFunc: method is nil but StatAPI.List was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc()
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *StatAPIMock) ListCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *StatAPIMock) ListWithContext(ctx context.Context) (*helium.Stats, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("StatAPIMock.ListWithContextFunc: method is nil but StatAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *StatAPIMock) ListWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// TokenSupply calls TokenSupplyFunc.
+func (mock *StatAPIMock) TokenSupply() (*helium.TokenSupply, error) {
+	if mock.TokenSupplyFunc == nil {
+		panic("StatAPIMock.TokenSupplyFunc: method is nil but StatAPI.TokenSupply was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockTokenSupply.Lock()
+	mock.calls.TokenSupply = append(mock.calls.TokenSupply, callInfo)
+	mock.lockTokenSupply.Unlock()
+	return mock.TokenSupplyFunc()
+}
+
+// TokenSupplyCalls gets all the calls that were made to TokenSupply.
+func (mock *StatAPIMock) TokenSupplyCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockTokenSupply.RLock()
+	calls = mock.calls.TokenSupply
+	mock.lockTokenSupply.RUnlock()
+	return calls
+}
+
+// TokenSupplyWithContext calls TokenSupplyWithContextFunc.
+func (mock *StatAPIMock) TokenSupplyWithContext(ctx context.Context) (*helium.TokenSupply, error) {
+	if mock.TokenSupplyWithContextFunc == nil {
+		panic("StatAPIMock.TokenSupplyWithContextFunc: method is nil but StatAPI.TokenSupplyWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockTokenSupplyWithContext.Lock()
+	mock.calls.TokenSupplyWithContext = append(mock.calls.TokenSupplyWithContext, callInfo)
+	mock.lockTokenSupplyWithContext.Unlock()
+	return mock.TokenSupplyWithContextFunc(ctx)
+}
+
+// TokenSupplyWithContextCalls gets all the calls that were made to TokenSupplyWithContext.
+func (mock *StatAPIMock) TokenSupplyWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockTokenSupplyWithContext.RLock()
+	calls = mock.calls.TokenSupplyWithContext
+	mock.lockTokenSupplyWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that TransactionAPIMock does implement helium.TransactionAPI.
+var _ helium.TransactionAPI = &TransactionAPIMock{}
+
+// TransactionAPIMock is a mock implementation of helium.TransactionAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type TransactionAPIMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(hash string) (*helium.TransactionInfo, error)
+
+	// GetWithContextFunc mocks the GetWithContext method.
+	GetWithContextFunc func(ctx context.Context, hash string) (*helium.TransactionInfo, error)
+
+	calls struct {
+		Get []struct {
+			Hash string
+		}
+		GetWithContext []struct {
+			Ctx  context.Context
+			Hash string
+		}
+	}
+	lockGet            sync.RWMutex
+	lockGetWithContext sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *TransactionAPIMock) Get(hash string) (*helium.TransactionInfo, error) {
+	if mock.GetFunc == nil {
+		panic("TransactionAPIMock.GetFunc: method is nil but TransactionAPI.Get was just called")
+	}
+	callInfo := struct {
+		Hash string
+	}{
+		Hash: hash,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(hash)
+}
+
+// GetCalls gets all the calls that were made to Get.
+func (mock *TransactionAPIMock) GetCalls() []struct {
+	Hash string
+} {
+	var calls []struct {
+		Hash string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (mock *TransactionAPIMock) GetWithContext(ctx context.Context, hash string) (*helium.TransactionInfo, error) {
+	if mock.GetWithContextFunc == nil {
+		panic("TransactionAPIMock.GetWithContextFunc: method is nil but TransactionAPI.GetWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Hash string
+	}{
+		Ctx:  ctx,
+		Hash: hash,
+	}
+	mock.lockGetWithContext.Lock()
+	mock.calls.GetWithContext = append(mock.calls.GetWithContext, callInfo)
+	mock.lockGetWithContext.Unlock()
+	return mock.GetWithContextFunc(ctx, hash)
+}
+
+// GetWithContextCalls gets all the calls that were made to GetWithContext.
+func (mock *TransactionAPIMock) GetWithContextCalls() []struct {
+	Ctx  context.Context
+	Hash string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Hash string
+	}
+	mock.lockGetWithContext.RLock()
+	calls = mock.calls.GetWithContext
+	mock.lockGetWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that PendingTransactionAPIMock does implement helium.PendingTransactionAPI.
+var _ helium.PendingTransactionAPI = &PendingTransactionAPIMock{}
+
+// PendingTransactionAPIMock is a mock implementation of helium.PendingTransactionAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type PendingTransactionAPIMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(input *helium.PendingTransactionInput) (*helium.PendingTransactions, error)
+
+	// GetWithContextFunc mocks the GetWithContext method.
+	GetWithContextFunc func(ctx context.Context, input *helium.PendingTransactionInput) (*helium.PendingTransactions, error)
+
+	// SubmitFunc mocks the Submit method.
+	SubmitFunc func(input *helium.TransactionSubmitInput) error
+
+	// SubmitWithContextFunc mocks the SubmitWithContext method.
+	SubmitWithContextFunc func(ctx context.Context, input *helium.TransactionSubmitInput) error
+
+	calls struct {
+		Get []struct {
+			Input *helium.PendingTransactionInput
+		}
+		GetWithContext []struct {
+			Ctx   context.Context
+			Input *helium.PendingTransactionInput
+		}
+		Submit []struct {
+			Input *helium.TransactionSubmitInput
+		}
+		SubmitWithContext []struct {
+			Ctx   context.Context
+			Input *helium.TransactionSubmitInput
+		}
+	}
+	lockGet               sync.RWMutex
+	lockGetWithContext    sync.RWMutex
+	lockSubmit            sync.RWMutex
+	lockSubmitWithContext sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *PendingTransactionAPIMock) Get(input *helium.PendingTransactionInput) (*helium.PendingTransactions, error) {
+	if mock.GetFunc == nil {
+		panic("PendingTransactionAPIMock.GetFunc: method is nil but PendingTransactionAPI.Get was just called")
+	}
+	callInfo := struct {
+		Input *helium.PendingTransactionInput
+	}{
+		Input: input,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(input)
+}
+
+// GetCalls gets all the calls that were made to Get.
+func (mock *PendingTransactionAPIMock) GetCalls() []struct {
+	Input *helium.PendingTransactionInput
+} {
+	var calls []struct {
+		Input *helium.PendingTransactionInput
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (mock *PendingTransactionAPIMock) GetWithContext(ctx context.Context, input *helium.PendingTransactionInput) (*helium.PendingTransactions, error) {
+	if mock.GetWithContextFunc == nil {
+		panic("PendingTransactionAPIMock.GetWithContextFunc: method is nil but PendingTransactionAPI.GetWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.PendingTransactionInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockGetWithContext.Lock()
+	mock.calls.GetWithContext = append(mock.calls.GetWithContext, callInfo)
+	mock.lockGetWithContext.Unlock()
+	return mock.GetWithContextFunc(ctx, input)
+}
+
+// GetWithContextCalls gets all the calls that were made to GetWithContext.
+func (mock *PendingTransactionAPIMock) GetWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.PendingTransactionInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.PendingTransactionInput
+	}
+	mock.lockGetWithContext.RLock()
+	calls = mock.calls.GetWithContext
+	mock.lockGetWithContext.RUnlock()
+	return calls
+}
+
+// Submit calls SubmitFunc.
+func (mock *PendingTransactionAPIMock) Submit(input *helium.TransactionSubmitInput) error {
+	if mock.SubmitFunc == nil {
+		panic("PendingTransactionAPIMock.SubmitFunc: method is nil but PendingTransactionAPI.Submit was just called")
+	}
+	callInfo := struct {
+		Input *helium.TransactionSubmitInput
+	}{
+		Input: input,
+	}
+	mock.lockSubmit.Lock()
+	mock.calls.Submit = append(mock.calls.Submit, callInfo)
+	mock.lockSubmit.Unlock()
+	return mock.SubmitFunc(input)
+}
+
+// SubmitCalls gets all the calls that were made to Submit.
+func (mock *PendingTransactionAPIMock) SubmitCalls() []struct {
+	Input *helium.TransactionSubmitInput
+} {
+	var calls []struct {
+		Input *helium.TransactionSubmitInput
+	}
+	mock.lockSubmit.RLock()
+	calls = mock.calls.Submit
+	mock.lockSubmit.RUnlock()
+	return calls
+}
+
+// SubmitWithContext calls SubmitWithContextFunc.
+func (mock *PendingTransactionAPIMock) SubmitWithContext(ctx context.Context, input *helium.TransactionSubmitInput) error {
+	if mock.SubmitWithContextFunc == nil {
+		panic("PendingTransactionAPIMock.SubmitWithContextFunc: method is nil but PendingTransactionAPI.SubmitWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.TransactionSubmitInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockSubmitWithContext.Lock()
+	mock.calls.SubmitWithContext = append(mock.calls.SubmitWithContext, callInfo)
+	mock.lockSubmitWithContext.Unlock()
+	return mock.SubmitWithContextFunc(ctx, input)
+}
+
+// SubmitWithContextCalls gets all the calls that were made to SubmitWithContext.
+func (mock *PendingTransactionAPIMock) SubmitWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.TransactionSubmitInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.TransactionSubmitInput
+	}
+	mock.lockSubmitWithContext.RLock()
+	calls = mock.calls.SubmitWithContext
+	mock.lockSubmitWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that RewardAPIMock does implement helium.RewardAPI.
+var _ helium.RewardAPI = &RewardAPIMock{}
+
+// RewardAPIMock is a mock implementation of helium.RewardAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type RewardAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.RewardListInput) (*helium.RewardTransactions, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.RewardListInput) (*helium.RewardTransactions, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(input *helium.RewardListInput, opts ...helium.PagerOption) *helium.RewardTransactionPager
+
+	// SumFunc mocks the Sum method.
+	SumFunc func(input *helium.RewardSumInput) (*helium.RewardStats, error)
+
+	// SumWithContextFunc mocks the SumWithContext method.
+	SumWithContextFunc func(ctx context.Context, input *helium.RewardSumInput) (*helium.RewardStats, error)
+
+	// SumBucketsFunc mocks the SumBuckets method.
+	SumBucketsFunc func(input *helium.RewardSumInput, bucket helium.Bucket) (*helium.RewardBuckets, error)
+
+	// SumBucketsWithContextFunc mocks the SumBucketsWithContext method.
+	SumBucketsWithContextFunc func(ctx context.Context, input *helium.RewardSumInput, bucket helium.Bucket) (*helium.RewardBuckets, error)
+
+	calls struct {
+		List []struct {
+			Input *helium.RewardListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.RewardListInput
+		}
+		ListAll []struct {
+			Input *helium.RewardListInput
+			Opts  []helium.PagerOption
+		}
+		Sum []struct {
+			Input *helium.RewardSumInput
+		}
+		SumWithContext []struct {
+			Ctx   context.Context
+			Input *helium.RewardSumInput
+		}
+		SumBuckets []struct {
+			Input  *helium.RewardSumInput
+			Bucket helium.Bucket
+		}
+		SumBucketsWithContext []struct {
+			Ctx    context.Context
+			Input  *helium.RewardSumInput
+			Bucket helium.Bucket
+		}
+	}
+	lockList                  sync.RWMutex
+	lockListWithContext       sync.RWMutex
+	lockListAll               sync.RWMutex
+	lockSum                   sync.RWMutex
+	lockSumWithContext        sync.RWMutex
+	lockSumBuckets            sync.RWMutex
+	lockSumBucketsWithContext sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *RewardAPIMock) List(input *helium.RewardListInput) (*helium.RewardTransactions, error) {
+	if mock.ListFunc == nil {
+		panic("RewardAPIMock.ListFunc: method is nil but RewardAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.RewardListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *RewardAPIMock) ListCalls() []struct {
+	Input *helium.RewardListInput
+} {
+	var calls []struct {
+		Input *helium.RewardListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *RewardAPIMock) ListWithContext(ctx context.Context, input *helium.RewardListInput) (*helium.RewardTransactions, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("RewardAPIMock.ListWithContextFunc: method is nil but RewardAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.RewardListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *RewardAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.RewardListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.RewardListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *RewardAPIMock) ListAll(input *helium.RewardListInput, opts ...helium.PagerOption) *helium.RewardTransactionPager {
+	if mock.ListAllFunc == nil {
+		panic("RewardAPIMock.ListAllFunc: method is nil but RewardAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.RewardListInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(input, opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *RewardAPIMock) ListAllCalls() []struct {
+	Input *helium.RewardListInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.RewardListInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Sum calls SumFunc.
+func (mock *RewardAPIMock) Sum(input *helium.RewardSumInput) (*helium.RewardStats, error) {
+	if mock.SumFunc == nil {
+		panic("RewardAPIMock.SumFunc: method is nil but RewardAPI.Sum was just called")
+	}
+	callInfo := struct {
+		Input *helium.RewardSumInput
+	}{
+		Input: input,
+	}
+	mock.lockSum.Lock()
+	mock.calls.Sum = append(mock.calls.Sum, callInfo)
+	mock.lockSum.Unlock()
+	return mock.SumFunc(input)
+}
+
+// SumCalls gets all the calls that were made to Sum.
+func (mock *RewardAPIMock) SumCalls() []struct {
+	Input *helium.RewardSumInput
+} {
+	var calls []struct {
+		Input *helium.RewardSumInput
+	}
+	mock.lockSum.RLock()
+	calls = mock.calls.Sum
+	mock.lockSum.RUnlock()
+	return calls
+}
+
+// SumWithContext calls SumWithContextFunc.
+func (mock *RewardAPIMock) SumWithContext(ctx context.Context, input *helium.RewardSumInput) (*helium.RewardStats, error) {
+	if mock.SumWithContextFunc == nil {
+		panic("RewardAPIMock.SumWithContextFunc: method is nil but RewardAPI.SumWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.RewardSumInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockSumWithContext.Lock()
+	mock.calls.SumWithContext = append(mock.calls.SumWithContext, callInfo)
+	mock.lockSumWithContext.Unlock()
+	return mock.SumWithContextFunc(ctx, input)
+}
+
+// SumWithContextCalls gets all the calls that were made to SumWithContext.
+func (mock *RewardAPIMock) SumWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.RewardSumInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.RewardSumInput
+	}
+	mock.lockSumWithContext.RLock()
+	calls = mock.calls.SumWithContext
+	mock.lockSumWithContext.RUnlock()
+	return calls
+}
+
+// SumBuckets calls SumBucketsFunc.
+func (mock *RewardAPIMock) SumBuckets(input *helium.RewardSumInput, bucket helium.Bucket) (*helium.RewardBuckets, error) {
+	if mock.SumBucketsFunc == nil {
+		panic("RewardAPIMock.SumBucketsFunc: method is nil but RewardAPI.SumBuckets was just called")
+	}
+	callInfo := struct {
+		Input  *helium.RewardSumInput
+		Bucket helium.Bucket
+	}{
+		Input:  input,
+		Bucket: bucket,
+	}
+	mock.lockSumBuckets.Lock()
+	mock.calls.SumBuckets = append(mock.calls.SumBuckets, callInfo)
+	mock.lockSumBuckets.Unlock()
+	return mock.SumBucketsFunc(input, bucket)
+}
+
+// SumBucketsCalls gets all the calls that were made to SumBuckets.
+func (mock *RewardAPIMock) SumBucketsCalls() []struct {
+	Input  *helium.RewardSumInput
+	Bucket helium.Bucket
+} {
+	var calls []struct {
+		Input  *helium.RewardSumInput
+		Bucket helium.Bucket
+	}
+	mock.lockSumBuckets.RLock()
+	calls = mock.calls.SumBuckets
+	mock.lockSumBuckets.RUnlock()
+	return calls
+}
+
+// SumBucketsWithContext calls SumBucketsWithContextFunc.
+func (mock *RewardAPIMock) SumBucketsWithContext(ctx context.Context, input *helium.RewardSumInput, bucket helium.Bucket) (*helium.RewardBuckets, error) {
+	if mock.SumBucketsWithContextFunc == nil {
+		panic("RewardAPIMock.SumBucketsWithContextFunc: method is nil but RewardAPI.SumBucketsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Input  *helium.RewardSumInput
+		Bucket helium.Bucket
+	}{
+		Ctx:    ctx,
+		Input:  input,
+		Bucket: bucket,
+	}
+	mock.lockSumBucketsWithContext.Lock()
+	mock.calls.SumBucketsWithContext = append(mock.calls.SumBucketsWithContext, callInfo)
+	mock.lockSumBucketsWithContext.Unlock()
+	return mock.SumBucketsWithContextFunc(ctx, input, bucket)
+}
+
+// SumBucketsWithContextCalls gets all the calls that were made to SumBucketsWithContext.
+func (mock *RewardAPIMock) SumBucketsWithContextCalls() []struct {
+	Ctx    context.Context
+	Input  *helium.RewardSumInput
+	Bucket helium.Bucket
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Input  *helium.RewardSumInput
+		Bucket helium.Bucket
+	}
+	mock.lockSumBucketsWithContext.RLock()
+	calls = mock.calls.SumBucketsWithContext
+	mock.lockSumBucketsWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that VarsAPIMock does implement helium.VarsAPI.
+var _ helium.VarsAPI = &VarsAPIMock{}
+
+// VarsAPIMock is a mock implementation of helium.VarsAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type VarsAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func() (*helium.ChainVars, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context) (*helium.ChainVars, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(name string) (*helium.ChainVar, error)
+
+	// GetWithContextFunc mocks the GetWithContext method.
+	GetWithContextFunc func(ctx context.Context, name string) (*helium.ChainVar, error)
+
+	// ActivityFunc mocks the Activity method.
+	ActivityFunc func(input *helium.VarActivityInput) (*helium.VarActivity, error)
+
+	// ActivityWithContextFunc mocks the ActivityWithContext method.
+	ActivityWithContextFunc func(ctx context.Context, input *helium.VarActivityInput) (*helium.VarActivity, error)
+
+	// ActivityAllFunc mocks the ActivityAll method.
+	ActivityAllFunc func(opts ...helium.PagerOption) *helium.VarActivityPager
+
+	calls struct {
+		List []struct {
+		}
+		ListWithContext []struct {
+			Ctx context.Context
+		}
+		Get []struct {
+			Name string
+		}
+		GetWithContext []struct {
+			Ctx  context.Context
+			Name string
+		}
+		Activity []struct {
+			Input *helium.VarActivityInput
+		}
+		ActivityWithContext []struct {
+			Ctx   context.Context
+			Input *helium.VarActivityInput
+		}
+		ActivityAll []struct {
+			Opts []helium.PagerOption
+		}
+	}
+	lockList                sync.RWMutex
+	lockListWithContext     sync.RWMutex
+	lockGet                 sync.RWMutex
+	lockGetWithContext      sync.RWMutex
+	lockActivity            sync.RWMutex
+	lockActivityWithContext sync.RWMutex
+	lockActivityAll         sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *VarsAPIMock) List() (*helium.ChainVars, error) {
+	if mock.ListFunc == nil {
+		panic("VarsAPIMock.ListFunc: method is nil but VarsAPI.List was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc()
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *VarsAPIMock) ListCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *VarsAPIMock) ListWithContext(ctx context.Context) (*helium.ChainVars, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("VarsAPIMock.ListWithContextFunc: method is nil but VarsAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *VarsAPIMock) ListWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *VarsAPIMock) Get(name string) (*helium.ChainVar, error) {
+	if mock.GetFunc == nil {
+		panic("VarsAPIMock.GetFunc: method is nil but VarsAPI.Get was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(name)
+}
+
+// GetCalls gets all the calls that were made to Get.
+func (mock *VarsAPIMock) GetCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (mock *VarsAPIMock) GetWithContext(ctx context.Context, name string) (*helium.ChainVar, error) {
+	if mock.GetWithContextFunc == nil {
+		panic("VarsAPIMock.GetWithContextFunc: method is nil but VarsAPI.GetWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetWithContext.Lock()
+	mock.calls.GetWithContext = append(mock.calls.GetWithContext, callInfo)
+	mock.lockGetWithContext.Unlock()
+	return mock.GetWithContextFunc(ctx, name)
+}
+
+// GetWithContextCalls gets all the calls that were made to GetWithContext.
+func (mock *VarsAPIMock) GetWithContextCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetWithContext.RLock()
+	calls = mock.calls.GetWithContext
+	mock.lockGetWithContext.RUnlock()
+	return calls
+}
+
+// Activity calls ActivityFunc.
+func (mock *VarsAPIMock) Activity(input *helium.VarActivityInput) (*helium.VarActivity, error) {
+	if mock.ActivityFunc == nil {
+		panic("VarsAPIMock.ActivityFunc: method is nil but VarsAPI.Activity was just called")
+	}
+	callInfo := struct {
+		Input *helium.VarActivityInput
+	}{
+		Input: input,
+	}
+	mock.lockActivity.Lock()
+	mock.calls.Activity = append(mock.calls.Activity, callInfo)
+	mock.lockActivity.Unlock()
+	return mock.ActivityFunc(input)
+}
+
+// ActivityCalls gets all the calls that were made to Activity.
+func (mock *VarsAPIMock) ActivityCalls() []struct {
+	Input *helium.VarActivityInput
+} {
+	var calls []struct {
+		Input *helium.VarActivityInput
+	}
+	mock.lockActivity.RLock()
+	calls = mock.calls.Activity
+	mock.lockActivity.RUnlock()
+	return calls
+}
+
+// ActivityWithContext calls ActivityWithContextFunc.
+func (mock *VarsAPIMock) ActivityWithContext(ctx context.Context, input *helium.VarActivityInput) (*helium.VarActivity, error) {
+	if mock.ActivityWithContextFunc == nil {
+		panic("VarsAPIMock.ActivityWithContextFunc: method is nil but VarsAPI.ActivityWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.VarActivityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockActivityWithContext.Lock()
+	mock.calls.ActivityWithContext = append(mock.calls.ActivityWithContext, callInfo)
+	mock.lockActivityWithContext.Unlock()
+	return mock.ActivityWithContextFunc(ctx, input)
+}
+
+// ActivityWithContextCalls gets all the calls that were made to ActivityWithContext.
+func (mock *VarsAPIMock) ActivityWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.VarActivityInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.VarActivityInput
+	}
+	mock.lockActivityWithContext.RLock()
+	calls = mock.calls.ActivityWithContext
+	mock.lockActivityWithContext.RUnlock()
+	return calls
+}
+
+// ActivityAll calls ActivityAllFunc.
+func (mock *VarsAPIMock) ActivityAll(opts ...helium.PagerOption) *helium.VarActivityPager {
+	if mock.ActivityAllFunc == nil {
+		panic("VarsAPIMock.ActivityAllFunc: method is nil but VarsAPI.ActivityAll was just called")
+	}
+	callInfo := struct {
+		Opts []helium.PagerOption
+	}{
+		Opts: opts,
+	}
+	mock.lockActivityAll.Lock()
+	mock.calls.ActivityAll = append(mock.calls.ActivityAll, callInfo)
+	mock.lockActivityAll.Unlock()
+	return mock.ActivityAllFunc(opts...)
+}
+
+// ActivityAllCalls gets all the calls that were made to ActivityAll.
+func (mock *VarsAPIMock) ActivityAllCalls() []struct {
+	Opts []helium.PagerOption
+} {
+	var calls []struct {
+		Opts []helium.PagerOption
+	}
+	mock.lockActivityAll.RLock()
+	calls = mock.calls.ActivityAll
+	mock.lockActivityAll.RUnlock()
+	return calls
+}
+
+// Ensure, that OuiAPIMock does implement helium.OuiAPI.
+var _ helium.OuiAPI = &OuiAPIMock{}
+
+// OuiAPIMock is a mock implementation of helium.OuiAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type OuiAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.OuiListInput) (*helium.Ouis, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.OuiListInput) (*helium.Ouis, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(opts ...helium.PagerOption) *helium.OuiPager
+
+	// GetFunc mocks the Get method.
+	GetFunc func(oui int) (*helium.OuiInfo, error)
+
+	// GetWithContextFunc mocks the GetWithContext method.
+	GetWithContextFunc func(ctx context.Context, oui int) (*helium.OuiInfo, error)
+
+	// LastFunc mocks the Last method.
+	LastFunc func() (*helium.OuiInfo, error)
+
+	// LastWithContextFunc mocks the LastWithContext method.
+	LastWithContextFunc func(ctx context.Context) (*helium.OuiInfo, error)
+
+	// StatsFunc mocks the Stats method.
+	StatsFunc func() (*helium.OuiStats, error)
+
+	// StatsWithContextFunc mocks the StatsWithContext method.
+	StatsWithContextFunc func(ctx context.Context) (*helium.OuiStats, error)
+
+	calls struct {
+		List []struct {
+			Input *helium.OuiListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.OuiListInput
+		}
+		ListAll []struct {
+			Opts []helium.PagerOption
+		}
+		Get []struct {
+			Oui int
+		}
+		GetWithContext []struct {
+			Ctx context.Context
+			Oui int
+		}
+		Last []struct {
+		}
+		LastWithContext []struct {
+			Ctx context.Context
+		}
+		Stats []struct {
+		}
+		StatsWithContext []struct {
+			Ctx context.Context
+		}
+	}
+	lockList             sync.RWMutex
+	lockListWithContext  sync.RWMutex
+	lockListAll          sync.RWMutex
+	lockGet              sync.RWMutex
+	lockGetWithContext   sync.RWMutex
+	lockLast             sync.RWMutex
+	lockLastWithContext  sync.RWMutex
+	lockStats            sync.RWMutex
+	lockStatsWithContext sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *OuiAPIMock) List(input *helium.OuiListInput) (*helium.Ouis, error) {
+	if mock.ListFunc == nil {
+		panic("OuiAPIMock.ListFunc: method is nil but OuiAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.OuiListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *OuiAPIMock) ListCalls() []struct {
+	Input *helium.OuiListInput
+} {
+	var calls []struct {
+		Input *helium.OuiListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *OuiAPIMock) ListWithContext(ctx context.Context, input *helium.OuiListInput) (*helium.Ouis, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("OuiAPIMock.ListWithContextFunc: method is nil but OuiAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.OuiListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *OuiAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.OuiListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.OuiListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *OuiAPIMock) ListAll(opts ...helium.PagerOption) *helium.OuiPager {
+	if mock.ListAllFunc == nil {
+		panic("OuiAPIMock.ListAllFunc: method is nil but OuiAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Opts []helium.PagerOption
+	}{
+		Opts: opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *OuiAPIMock) ListAllCalls() []struct {
+	Opts []helium.PagerOption
+} {
+	var calls []struct {
+		Opts []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *OuiAPIMock) Get(oui int) (*helium.OuiInfo, error) {
+	if mock.GetFunc == nil {
+		panic("OuiAPIMock.GetFunc: method is nil but OuiAPI.Get was just called")
+	}
+	callInfo := struct {
+		Oui int
+	}{
+		Oui: oui,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(oui)
+}
+
+// GetCalls gets all the calls that were made to Get.
+func (mock *OuiAPIMock) GetCalls() []struct {
+	Oui int
+} {
+	var calls []struct {
+		Oui int
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (mock *OuiAPIMock) GetWithContext(ctx context.Context, oui int) (*helium.OuiInfo, error) {
+	if mock.GetWithContextFunc == nil {
+		panic("OuiAPIMock.GetWithContextFunc: method is nil but OuiAPI.GetWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Oui int
+	}{
+		Ctx: ctx,
+		Oui: oui,
+	}
+	mock.lockGetWithContext.Lock()
+	mock.calls.GetWithContext = append(mock.calls.GetWithContext, callInfo)
+	mock.lockGetWithContext.Unlock()
+	return mock.GetWithContextFunc(ctx, oui)
+}
+
+// GetWithContextCalls gets all the calls that were made to GetWithContext.
+func (mock *OuiAPIMock) GetWithContextCalls() []struct {
+	Ctx context.Context
+	Oui int
+} {
+	var calls []struct {
+		Ctx context.Context
+		Oui int
+	}
+	mock.lockGetWithContext.RLock()
+	calls = mock.calls.GetWithContext
+	mock.lockGetWithContext.RUnlock()
+	return calls
+}
+
+// Last calls LastFunc.
+func (mock *OuiAPIMock) Last() (*helium.OuiInfo, error) {
+	if mock.LastFunc == nil {
+		panic("OuiAPIMock.LastFunc: method is nil but OuiAPI.Last was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockLast.Lock()
+	mock.calls.Last = append(mock.calls.Last, callInfo)
+	mock.lockLast.Unlock()
+	return mock.LastFunc()
+}
+
+// LastCalls gets all the calls that were made to Last.
+func (mock *OuiAPIMock) LastCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockLast.RLock()
+	calls = mock.calls.Last
+	mock.lockLast.RUnlock()
+	return calls
+}
+
+// LastWithContext calls LastWithContextFunc.
+func (mock *OuiAPIMock) LastWithContext(ctx context.Context) (*helium.OuiInfo, error) {
+	if mock.LastWithContextFunc == nil {
+		panic("OuiAPIMock.LastWithContextFunc: method is nil but OuiAPI.LastWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockLastWithContext.Lock()
+	mock.calls.LastWithContext = append(mock.calls.LastWithContext, callInfo)
+	mock.lockLastWithContext.Unlock()
+	return mock.LastWithContextFunc(ctx)
+}
+
+// LastWithContextCalls gets all the calls that were made to LastWithContext.
+func (mock *OuiAPIMock) LastWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockLastWithContext.RLock()
+	calls = mock.calls.LastWithContext
+	mock.lockLastWithContext.RUnlock()
+	return calls
+}
+
+// Stats calls StatsFunc.
+func (mock *OuiAPIMock) Stats() (*helium.OuiStats, error) {
+	if mock.StatsFunc == nil {
+		panic("OuiAPIMock.StatsFunc: method is nil but OuiAPI.Stats was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockStats.Lock()
+	mock.calls.Stats = append(mock.calls.Stats, callInfo)
+	mock.lockStats.Unlock()
+	return mock.StatsFunc()
+}
+
+// StatsCalls gets all the calls that were made to Stats.
+func (mock *OuiAPIMock) StatsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockStats.RLock()
+	calls = mock.calls.Stats
+	mock.lockStats.RUnlock()
+	return calls
+}
+
+// StatsWithContext calls StatsWithContextFunc.
+func (mock *OuiAPIMock) StatsWithContext(ctx context.Context) (*helium.OuiStats, error) {
+	if mock.StatsWithContextFunc == nil {
+		panic("OuiAPIMock.StatsWithContextFunc: method is nil but OuiAPI.StatsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockStatsWithContext.Lock()
+	mock.calls.StatsWithContext = append(mock.calls.StatsWithContext, callInfo)
+	mock.lockStatsWithContext.Unlock()
+	return mock.StatsWithContextFunc(ctx)
+}
+
+// StatsWithContextCalls gets all the calls that were made to StatsWithContext.
+func (mock *OuiAPIMock) StatsWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockStatsWithContext.RLock()
+	calls = mock.calls.StatsWithContext
+	mock.lockStatsWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that StateChannelAPIMock does implement helium.StateChannelAPI.
+var _ helium.StateChannelAPI = &StateChannelAPIMock{}
+
+// StateChannelAPIMock is a mock implementation of helium.StateChannelAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type StateChannelAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.StateChannelListInput) (*helium.StateChannels, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.StateChannelListInput) (*helium.StateChannels, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(opts ...helium.PagerOption) *helium.StateChannelPager
+
+	// StatsFunc mocks the Stats method.
+	StatsFunc func() (*helium.StateChannelStats, error)
+
+	// StatsWithContextFunc mocks the StatsWithContext method.
+	StatsWithContextFunc func(ctx context.Context) (*helium.StateChannelStats, error)
+
+	// HotspotTrafficFunc mocks the HotspotTraffic method.
+	HotspotTrafficFunc func(input *helium.HotspotTrafficInput) (*helium.HotspotTraffic, error)
+
+	// HotspotTrafficWithContextFunc mocks the HotspotTrafficWithContext method.
+	HotspotTrafficWithContextFunc func(ctx context.Context, input *helium.HotspotTrafficInput) (*helium.HotspotTraffic, error)
+
+	calls struct {
+		List []struct {
+			Input *helium.StateChannelListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.StateChannelListInput
+		}
+		ListAll []struct {
+			Opts []helium.PagerOption
+		}
+		Stats []struct {
+		}
+		StatsWithContext []struct {
+			Ctx context.Context
+		}
+		HotspotTraffic []struct {
+			Input *helium.HotspotTrafficInput
+		}
+		HotspotTrafficWithContext []struct {
+			Ctx   context.Context
+			Input *helium.HotspotTrafficInput
+		}
+	}
+	lockList                      sync.RWMutex
+	lockListWithContext           sync.RWMutex
+	lockListAll                   sync.RWMutex
+	lockStats                     sync.RWMutex
+	lockStatsWithContext          sync.RWMutex
+	lockHotspotTraffic            sync.RWMutex
+	lockHotspotTrafficWithContext sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *StateChannelAPIMock) List(input *helium.StateChannelListInput) (*helium.StateChannels, error) {
+	if mock.ListFunc == nil {
+		panic("StateChannelAPIMock.ListFunc: method is nil but StateChannelAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.StateChannelListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *StateChannelAPIMock) ListCalls() []struct {
+	Input *helium.StateChannelListInput
+} {
+	var calls []struct {
+		Input *helium.StateChannelListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *StateChannelAPIMock) ListWithContext(ctx context.Context, input *helium.StateChannelListInput) (*helium.StateChannels, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("StateChannelAPIMock.ListWithContextFunc: method is nil but StateChannelAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.StateChannelListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *StateChannelAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.StateChannelListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.StateChannelListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *StateChannelAPIMock) ListAll(opts ...helium.PagerOption) *helium.StateChannelPager {
+	if mock.ListAllFunc == nil {
+		panic("StateChannelAPIMock.ListAllFunc: method is nil but StateChannelAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Opts []helium.PagerOption
+	}{
+		Opts: opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *StateChannelAPIMock) ListAllCalls() []struct {
+	Opts []helium.PagerOption
+} {
+	var calls []struct {
+		Opts []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Stats calls StatsFunc.
+func (mock *StateChannelAPIMock) Stats() (*helium.StateChannelStats, error) {
+	if mock.StatsFunc == nil {
+		panic("StateChannelAPIMock.StatsFunc: method is nil but StateChannelAPI.Stats was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockStats.Lock()
+	mock.calls.Stats = append(mock.calls.Stats, callInfo)
+	mock.lockStats.Unlock()
+	return mock.StatsFunc()
+}
+
+// StatsCalls gets all the calls that were made to Stats.
+func (mock *StateChannelAPIMock) StatsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockStats.RLock()
+	calls = mock.calls.Stats
+	mock.lockStats.RUnlock()
+	return calls
+}
+
+// StatsWithContext calls StatsWithContextFunc.
+func (mock *StateChannelAPIMock) StatsWithContext(ctx context.Context) (*helium.StateChannelStats, error) {
+	if mock.StatsWithContextFunc == nil {
+		panic("StateChannelAPIMock.StatsWithContextFunc: method is nil but StateChannelAPI.StatsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockStatsWithContext.Lock()
+	mock.calls.StatsWithContext = append(mock.calls.StatsWithContext, callInfo)
+	mock.lockStatsWithContext.Unlock()
+	return mock.StatsWithContextFunc(ctx)
+}
+
+// StatsWithContextCalls gets all the calls that were made to StatsWithContext.
+func (mock *StateChannelAPIMock) StatsWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockStatsWithContext.RLock()
+	calls = mock.calls.StatsWithContext
+	mock.lockStatsWithContext.RUnlock()
+	return calls
+}
+
+// HotspotTraffic calls HotspotTrafficFunc.
+func (mock *StateChannelAPIMock) HotspotTraffic(input *helium.HotspotTrafficInput) (*helium.HotspotTraffic, error) {
+	if mock.HotspotTrafficFunc == nil {
+		panic("StateChannelAPIMock.HotspotTrafficFunc: method is nil but StateChannelAPI.HotspotTraffic was just called")
+	}
+	callInfo := struct {
+		Input *helium.HotspotTrafficInput
+	}{
+		Input: input,
+	}
+	mock.lockHotspotTraffic.Lock()
+	mock.calls.HotspotTraffic = append(mock.calls.HotspotTraffic, callInfo)
+	mock.lockHotspotTraffic.Unlock()
+	return mock.HotspotTrafficFunc(input)
+}
+
+// HotspotTrafficCalls gets all the calls that were made to HotspotTraffic.
+func (mock *StateChannelAPIMock) HotspotTrafficCalls() []struct {
+	Input *helium.HotspotTrafficInput
+} {
+	var calls []struct {
+		Input *helium.HotspotTrafficInput
+	}
+	mock.lockHotspotTraffic.RLock()
+	calls = mock.calls.HotspotTraffic
+	mock.lockHotspotTraffic.RUnlock()
+	return calls
+}
+
+// HotspotTrafficWithContext calls HotspotTrafficWithContextFunc.
+func (mock *StateChannelAPIMock) HotspotTrafficWithContext(ctx context.Context, input *helium.HotspotTrafficInput) (*helium.HotspotTraffic, error) {
+	if mock.HotspotTrafficWithContextFunc == nil {
+		panic("StateChannelAPIMock.HotspotTrafficWithContextFunc: method is nil but StateChannelAPI.HotspotTrafficWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.HotspotTrafficInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockHotspotTrafficWithContext.Lock()
+	mock.calls.HotspotTrafficWithContext = append(mock.calls.HotspotTrafficWithContext, callInfo)
+	mock.lockHotspotTrafficWithContext.Unlock()
+	return mock.HotspotTrafficWithContextFunc(ctx, input)
+}
+
+// HotspotTrafficWithContextCalls gets all the calls that were made to HotspotTrafficWithContext.
+func (mock *StateChannelAPIMock) HotspotTrafficWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.HotspotTrafficInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.HotspotTrafficInput
+	}
+	mock.lockHotspotTrafficWithContext.RLock()
+	calls = mock.calls.HotspotTrafficWithContext
+	mock.lockHotspotTrafficWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that DCBurnAPIMock does implement helium.DCBurnAPI.
+var _ helium.DCBurnAPI = &DCBurnAPIMock{}
+
+// DCBurnAPIMock is a mock implementation of helium.DCBurnAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type DCBurnAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.DCBurnListInput) (*helium.DCBurns, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.DCBurnListInput) (*helium.DCBurns, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(input *helium.DCBurnListInput, opts ...helium.PagerOption) *helium.DCBurnPager
+
+	// SumFunc mocks the Sum method.
+	SumFunc func(input *helium.DCBurnSumInput) (*helium.DCBurnSum, error)
+
+	// SumWithContextFunc mocks the SumWithContext method.
+	SumWithContextFunc func(ctx context.Context, input *helium.DCBurnSumInput) (*helium.DCBurnSum, error)
+
+	// SumBucketsFunc mocks the SumBuckets method.
+	SumBucketsFunc func(input *helium.DCBurnSumInput, bucket helium.Bucket) (*helium.DCBurnBuckets, error)
+
+	// SumBucketsWithContextFunc mocks the SumBucketsWithContext method.
+	SumBucketsWithContextFunc func(ctx context.Context, input *helium.DCBurnSumInput, bucket helium.Bucket) (*helium.DCBurnBuckets, error)
+
+	// StatsFunc mocks the Stats method.
+	StatsFunc func() (*helium.DCBurnStats, error)
+
+	// StatsWithContextFunc mocks the StatsWithContext method.
+	StatsWithContextFunc func(ctx context.Context) (*helium.DCBurnStats, error)
+
+	// ValueFunc mocks the Value method.
+	ValueFunc func(burns []helium.DCBurnData) (*helium.DCBurnValue, error)
+
+	// ValueWithContextFunc mocks the ValueWithContext method.
+	ValueWithContextFunc func(ctx context.Context, burns []helium.DCBurnData) (*helium.DCBurnValue, error)
+
+	calls struct {
+		List []struct {
+			Input *helium.DCBurnListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.DCBurnListInput
+		}
+		ListAll []struct {
+			Input *helium.DCBurnListInput
+			Opts  []helium.PagerOption
+		}
+		Sum []struct {
+			Input *helium.DCBurnSumInput
+		}
+		SumWithContext []struct {
+			Ctx   context.Context
+			Input *helium.DCBurnSumInput
+		}
+		SumBuckets []struct {
+			Input  *helium.DCBurnSumInput
+			Bucket helium.Bucket
+		}
+		SumBucketsWithContext []struct {
+			Ctx    context.Context
+			Input  *helium.DCBurnSumInput
+			Bucket helium.Bucket
+		}
+		Stats []struct {
+		}
+		StatsWithContext []struct {
+			Ctx context.Context
+		}
+		Value []struct {
+			Burns []helium.DCBurnData
+		}
+		ValueWithContext []struct {
+			Ctx   context.Context
+			Burns []helium.DCBurnData
+		}
+	}
+	lockList                  sync.RWMutex
+	lockListWithContext       sync.RWMutex
+	lockListAll               sync.RWMutex
+	lockSum                   sync.RWMutex
+	lockSumWithContext        sync.RWMutex
+	lockSumBuckets            sync.RWMutex
+	lockSumBucketsWithContext sync.RWMutex
+	lockStats                 sync.RWMutex
+	lockStatsWithContext      sync.RWMutex
+	lockValue                 sync.RWMutex
+	lockValueWithContext      sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *DCBurnAPIMock) List(input *helium.DCBurnListInput) (*helium.DCBurns, error) {
+	if mock.ListFunc == nil {
+		panic("DCBurnAPIMock.ListFunc: method is nil but DCBurnAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.DCBurnListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *DCBurnAPIMock) ListCalls() []struct {
+	Input *helium.DCBurnListInput
+} {
+	var calls []struct {
+		Input *helium.DCBurnListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *DCBurnAPIMock) ListWithContext(ctx context.Context, input *helium.DCBurnListInput) (*helium.DCBurns, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("DCBurnAPIMock.ListWithContextFunc: method is nil but DCBurnAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.DCBurnListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *DCBurnAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.DCBurnListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.DCBurnListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *DCBurnAPIMock) ListAll(input *helium.DCBurnListInput, opts ...helium.PagerOption) *helium.DCBurnPager {
+	if mock.ListAllFunc == nil {
+		panic("DCBurnAPIMock.ListAllFunc: method is nil but DCBurnAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.DCBurnListInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(input, opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *DCBurnAPIMock) ListAllCalls() []struct {
+	Input *helium.DCBurnListInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.DCBurnListInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Sum calls SumFunc.
+func (mock *DCBurnAPIMock) Sum(input *helium.DCBurnSumInput) (*helium.DCBurnSum, error) {
+	if mock.SumFunc == nil {
+		panic("DCBurnAPIMock.SumFunc: method is nil but DCBurnAPI.Sum was just called")
+	}
+	callInfo := struct {
+		Input *helium.DCBurnSumInput
+	}{
+		Input: input,
+	}
+	mock.lockSum.Lock()
+	mock.calls.Sum = append(mock.calls.Sum, callInfo)
+	mock.lockSum.Unlock()
+	return mock.SumFunc(input)
+}
+
+// SumCalls gets all the calls that were made to Sum.
+func (mock *DCBurnAPIMock) SumCalls() []struct {
+	Input *helium.DCBurnSumInput
+} {
+	var calls []struct {
+		Input *helium.DCBurnSumInput
+	}
+	mock.lockSum.RLock()
+	calls = mock.calls.Sum
+	mock.lockSum.RUnlock()
+	return calls
+}
+
+// SumWithContext calls SumWithContextFunc.
+func (mock *DCBurnAPIMock) SumWithContext(ctx context.Context, input *helium.DCBurnSumInput) (*helium.DCBurnSum, error) {
+	if mock.SumWithContextFunc == nil {
+		panic("DCBurnAPIMock.SumWithContextFunc: method is nil but DCBurnAPI.SumWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.DCBurnSumInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockSumWithContext.Lock()
+	mock.calls.SumWithContext = append(mock.calls.SumWithContext, callInfo)
+	mock.lockSumWithContext.Unlock()
+	return mock.SumWithContextFunc(ctx, input)
+}
+
+// SumWithContextCalls gets all the calls that were made to SumWithContext.
+func (mock *DCBurnAPIMock) SumWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.DCBurnSumInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.DCBurnSumInput
+	}
+	mock.lockSumWithContext.RLock()
+	calls = mock.calls.SumWithContext
+	mock.lockSumWithContext.RUnlock()
+	return calls
+}
+
+// SumBuckets calls SumBucketsFunc.
+func (mock *DCBurnAPIMock) SumBuckets(input *helium.DCBurnSumInput, bucket helium.Bucket) (*helium.DCBurnBuckets, error) {
+	if mock.SumBucketsFunc == nil {
+		panic("DCBurnAPIMock.SumBucketsFunc: method is nil but DCBurnAPI.SumBuckets was just called")
+	}
+	callInfo := struct {
+		Input  *helium.DCBurnSumInput
+		Bucket helium.Bucket
+	}{
+		Input:  input,
+		Bucket: bucket,
+	}
+	mock.lockSumBuckets.Lock()
+	mock.calls.SumBuckets = append(mock.calls.SumBuckets, callInfo)
+	mock.lockSumBuckets.Unlock()
+	return mock.SumBucketsFunc(input, bucket)
+}
+
+// SumBucketsCalls gets all the calls that were made to SumBuckets.
+func (mock *DCBurnAPIMock) SumBucketsCalls() []struct {
+	Input  *helium.DCBurnSumInput
+	Bucket helium.Bucket
+} {
+	var calls []struct {
+		Input  *helium.DCBurnSumInput
+		Bucket helium.Bucket
+	}
+	mock.lockSumBuckets.RLock()
+	calls = mock.calls.SumBuckets
+	mock.lockSumBuckets.RUnlock()
+	return calls
+}
+
+// SumBucketsWithContext calls SumBucketsWithContextFunc.
+func (mock *DCBurnAPIMock) SumBucketsWithContext(ctx context.Context, input *helium.DCBurnSumInput, bucket helium.Bucket) (*helium.DCBurnBuckets, error) {
+	if mock.SumBucketsWithContextFunc == nil {
+		panic("DCBurnAPIMock.SumBucketsWithContextFunc: method is nil but DCBurnAPI.SumBucketsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Input  *helium.DCBurnSumInput
+		Bucket helium.Bucket
+	}{
+		Ctx:    ctx,
+		Input:  input,
+		Bucket: bucket,
+	}
+	mock.lockSumBucketsWithContext.Lock()
+	mock.calls.SumBucketsWithContext = append(mock.calls.SumBucketsWithContext, callInfo)
+	mock.lockSumBucketsWithContext.Unlock()
+	return mock.SumBucketsWithContextFunc(ctx, input, bucket)
+}
+
+// SumBucketsWithContextCalls gets all the calls that were made to SumBucketsWithContext.
+func (mock *DCBurnAPIMock) SumBucketsWithContextCalls() []struct {
+	Ctx    context.Context
+	Input  *helium.DCBurnSumInput
+	Bucket helium.Bucket
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Input  *helium.DCBurnSumInput
+		Bucket helium.Bucket
+	}
+	mock.lockSumBucketsWithContext.RLock()
+	calls = mock.calls.SumBucketsWithContext
+	mock.lockSumBucketsWithContext.RUnlock()
+	return calls
+}
+
+// Stats calls StatsFunc.
+func (mock *DCBurnAPIMock) Stats() (*helium.DCBurnStats, error) {
+	if mock.StatsFunc == nil {
+		panic("DCBurnAPIMock.StatsFunc: method is nil but DCBurnAPI.Stats was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockStats.Lock()
+	mock.calls.Stats = append(mock.calls.Stats, callInfo)
+	mock.lockStats.Unlock()
+	return mock.StatsFunc()
+}
+
+// StatsCalls gets all the calls that were made to Stats.
+func (mock *DCBurnAPIMock) StatsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockStats.RLock()
+	calls = mock.calls.Stats
+	mock.lockStats.RUnlock()
+	return calls
+}
+
+// StatsWithContext calls StatsWithContextFunc.
+func (mock *DCBurnAPIMock) StatsWithContext(ctx context.Context) (*helium.DCBurnStats, error) {
+	if mock.StatsWithContextFunc == nil {
+		panic("DCBurnAPIMock.StatsWithContextFunc: method is nil but DCBurnAPI.StatsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockStatsWithContext.Lock()
+	mock.calls.StatsWithContext = append(mock.calls.StatsWithContext, callInfo)
+	mock.lockStatsWithContext.Unlock()
+	return mock.StatsWithContextFunc(ctx)
+}
+
+// StatsWithContextCalls gets all the calls that were made to StatsWithContext.
+func (mock *DCBurnAPIMock) StatsWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockStatsWithContext.RLock()
+	calls = mock.calls.StatsWithContext
+	mock.lockStatsWithContext.RUnlock()
+	return calls
+}
+
+// Value calls ValueFunc.
+func (mock *DCBurnAPIMock) Value(burns []helium.DCBurnData) (*helium.DCBurnValue, error) {
+	if mock.ValueFunc == nil {
+		panic("DCBurnAPIMock.ValueFunc: method is nil but DCBurnAPI.Value was just called")
+	}
+	callInfo := struct {
+		Burns []helium.DCBurnData
+	}{
+		Burns: burns,
+	}
+	mock.lockValue.Lock()
+	mock.calls.Value = append(mock.calls.Value, callInfo)
+	mock.lockValue.Unlock()
+	return mock.ValueFunc(burns)
+}
+
+// ValueCalls gets all the calls that were made to Value.
+func (mock *DCBurnAPIMock) ValueCalls() []struct {
+	Burns []helium.DCBurnData
+} {
+	var calls []struct {
+		Burns []helium.DCBurnData
+	}
+	mock.lockValue.RLock()
+	calls = mock.calls.Value
+	mock.lockValue.RUnlock()
+	return calls
+}
+
+// ValueWithContext calls ValueWithContextFunc.
+func (mock *DCBurnAPIMock) ValueWithContext(ctx context.Context, burns []helium.DCBurnData) (*helium.DCBurnValue, error) {
+	if mock.ValueWithContextFunc == nil {
+		panic("DCBurnAPIMock.ValueWithContextFunc: method is nil but DCBurnAPI.ValueWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Burns []helium.DCBurnData
+	}{
+		Ctx:   ctx,
+		Burns: burns,
+	}
+	mock.lockValueWithContext.Lock()
+	mock.calls.ValueWithContext = append(mock.calls.ValueWithContext, callInfo)
+	mock.lockValueWithContext.Unlock()
+	return mock.ValueWithContextFunc(ctx, burns)
+}
+
+// ValueWithContextCalls gets all the calls that were made to ValueWithContext.
+func (mock *DCBurnAPIMock) ValueWithContextCalls() []struct {
+	Ctx   context.Context
+	Burns []helium.DCBurnData
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Burns []helium.DCBurnData
+	}
+	mock.lockValueWithContext.RLock()
+	calls = mock.calls.ValueWithContext
+	mock.lockValueWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that ChallengeAPIMock does implement helium.ChallengeAPI.
+var _ helium.ChallengeAPI = &ChallengeAPIMock{}
+
+// ChallengeAPIMock is a mock implementation of helium.ChallengeAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type ChallengeAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.ChallengeListInput) (*helium.Challenges, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.ChallengeListInput) (*helium.Challenges, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(input *helium.ChallengeListInput, opts ...helium.PagerOption) *helium.ChallengePager
+
+	// StatsFunc mocks the Stats method.
+	StatsFunc func() (*helium.ChallengeStats, error)
+
+	// StatsWithContextFunc mocks the StatsWithContext method.
+	StatsWithContextFunc func(ctx context.Context) (*helium.ChallengeStats, error)
+
+	calls struct {
+		List []struct {
+			Input *helium.ChallengeListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.ChallengeListInput
+		}
+		ListAll []struct {
+			Input *helium.ChallengeListInput
+			Opts  []helium.PagerOption
+		}
+		Stats []struct {
+		}
+		StatsWithContext []struct {
+			Ctx context.Context
+		}
+	}
+	lockList             sync.RWMutex
+	lockListWithContext  sync.RWMutex
+	lockListAll          sync.RWMutex
+	lockStats            sync.RWMutex
+	lockStatsWithContext sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *ChallengeAPIMock) List(input *helium.ChallengeListInput) (*helium.Challenges, error) {
+	if mock.ListFunc == nil {
+		panic("ChallengeAPIMock.ListFunc: method is nil but ChallengeAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.ChallengeListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *ChallengeAPIMock) ListCalls() []struct {
+	Input *helium.ChallengeListInput
+} {
+	var calls []struct {
+		Input *helium.ChallengeListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *ChallengeAPIMock) ListWithContext(ctx context.Context, input *helium.ChallengeListInput) (*helium.Challenges, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("ChallengeAPIMock.ListWithContextFunc: method is nil but ChallengeAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.ChallengeListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *ChallengeAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.ChallengeListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.ChallengeListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *ChallengeAPIMock) ListAll(input *helium.ChallengeListInput, opts ...helium.PagerOption) *helium.ChallengePager {
+	if mock.ListAllFunc == nil {
+		panic("ChallengeAPIMock.ListAllFunc: method is nil but ChallengeAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.ChallengeListInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(input, opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *ChallengeAPIMock) ListAllCalls() []struct {
+	Input *helium.ChallengeListInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.ChallengeListInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
+
+// Stats calls StatsFunc.
+func (mock *ChallengeAPIMock) Stats() (*helium.ChallengeStats, error) {
+	if mock.StatsFunc == nil {
+		panic("ChallengeAPIMock.StatsFunc: method is nil but ChallengeAPI.Stats was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockStats.Lock()
+	mock.calls.Stats = append(mock.calls.Stats, callInfo)
+	mock.lockStats.Unlock()
+	return mock.StatsFunc()
+}
+
+// StatsCalls gets all the calls that were made to Stats.
+func (mock *ChallengeAPIMock) StatsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockStats.RLock()
+	calls = mock.calls.Stats
+	mock.lockStats.RUnlock()
+	return calls
+}
+
+// StatsWithContext calls StatsWithContextFunc.
+func (mock *ChallengeAPIMock) StatsWithContext(ctx context.Context) (*helium.ChallengeStats, error) {
+	if mock.StatsWithContextFunc == nil {
+		panic("ChallengeAPIMock.StatsWithContextFunc: method is nil but ChallengeAPI.StatsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockStatsWithContext.Lock()
+	mock.calls.StatsWithContext = append(mock.calls.StatsWithContext, callInfo)
+	mock.lockStatsWithContext.Unlock()
+	return mock.StatsWithContextFunc(ctx)
+}
+
+// StatsWithContextCalls gets all the calls that were made to StatsWithContext.
+func (mock *ChallengeAPIMock) StatsWithContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockStatsWithContext.RLock()
+	calls = mock.calls.StatsWithContext
+	mock.lockStatsWithContext.RUnlock()
+	return calls
+}
+
+// Ensure, that ElectionAPIMock does implement helium.ElectionAPI.
+var _ helium.ElectionAPI = &ElectionAPIMock{}
+
+// ElectionAPIMock is a mock implementation of helium.ElectionAPI.
+//
+// Set the Func field of each method the code under test calls, calling a method
+// whose Func is nil panics.
+type ElectionAPIMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(input *helium.ElectionListInput) (*helium.Elections, error)
+
+	// ListWithContextFunc mocks the ListWithContext method.
+	ListWithContextFunc func(ctx context.Context, input *helium.ElectionListInput) (*helium.Elections, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(input *helium.ElectionListInput, opts ...helium.PagerOption) *helium.ElectionPager
+
+	calls struct {
+		List []struct {
+			Input *helium.ElectionListInput
+		}
+		ListWithContext []struct {
+			Ctx   context.Context
+			Input *helium.ElectionListInput
+		}
+		ListAll []struct {
+			Input *helium.ElectionListInput
+			Opts  []helium.PagerOption
+		}
+	}
+	lockList            sync.RWMutex
+	lockListWithContext sync.RWMutex
+	lockListAll         sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *ElectionAPIMock) List(input *helium.ElectionListInput) (*helium.Elections, error) {
+	if mock.ListFunc == nil {
+		panic("ElectionAPIMock.ListFunc: method is nil but ElectionAPI.List was just called")
+	}
+	callInfo := struct {
+		Input *helium.ElectionListInput
+	}{
+		Input: input,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(input)
+}
+
+// ListCalls gets all the calls that were made to List.
+func (mock *ElectionAPIMock) ListCalls() []struct {
+	Input *helium.ElectionListInput
+} {
+	var calls []struct {
+		Input *helium.ElectionListInput
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListWithContext calls ListWithContextFunc.
+func (mock *ElectionAPIMock) ListWithContext(ctx context.Context, input *helium.ElectionListInput) (*helium.Elections, error) {
+	if mock.ListWithContextFunc == nil {
+		panic("ElectionAPIMock.ListWithContextFunc: method is nil but ElectionAPI.ListWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Input *helium.ElectionListInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockListWithContext.Lock()
+	mock.calls.ListWithContext = append(mock.calls.ListWithContext, callInfo)
+	mock.lockListWithContext.Unlock()
+	return mock.ListWithContextFunc(ctx, input)
+}
+
+// ListWithContextCalls gets all the calls that were made to ListWithContext.
+func (mock *ElectionAPIMock) ListWithContextCalls() []struct {
+	Ctx   context.Context
+	Input *helium.ElectionListInput
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Input *helium.ElectionListInput
+	}
+	mock.lockListWithContext.RLock()
+	calls = mock.calls.ListWithContext
+	mock.lockListWithContext.RUnlock()
+	return calls
+}
+
+// ListAll calls ListAllFunc.
+func (mock *ElectionAPIMock) ListAll(input *helium.ElectionListInput, opts ...helium.PagerOption) *helium.ElectionPager {
+	if mock.ListAllFunc == nil {
+		panic("ElectionAPIMock.ListAllFunc: method is nil but ElectionAPI.ListAll was just called")
+	}
+	callInfo := struct {
+		Input *helium.ElectionListInput
+		Opts  []helium.PagerOption
+	}{
+		Input: input,
+		Opts:  opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(input, opts...)
+}
+
+// ListAllCalls gets all the calls that were made to ListAll.
+func (mock *ElectionAPIMock) ListAllCalls() []struct {
+	Input *helium.ElectionListInput
+	Opts  []helium.PagerOption
+} {
+	var calls []struct {
+		Input *helium.ElectionListInput
+		Opts  []helium.PagerOption
+	}
+	mock.lockListAll.RLock()
+	calls = mock.calls.ListAll
+	mock.lockListAll.RUnlock()
+	return calls
+}
@@ -0,0 +1,62 @@
+package heliummock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	helium "github.com/dougkirkley/helium-go"
+	"github.com/dougkirkley/helium-go/heliumtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// ownerNames is the kind of application code the mocks stand in for
+func ownerNames(ctx context.Context, api helium.API, owner helium.Address) ([]string, error) {
+	var names []string
+	hotspots := api.Account().HotspotsAll(&helium.AccountInput{ID: owner})
+	for hotspots.Next(ctx) {
+		names = append(names, hotspots.Item().Name)
+	}
+	return names, hotspots.Err()
+}
+
+func TestMock(t *testing.T) {
+	pages := map[string]*helium.Hotspots{
+		"":  {Data: []helium.HotspotData{{Name: "a"}}, Cursor: "2"},
+		"2": {Data: []helium.HotspotData{{Name: "b"}}},
+	}
+	accounts := &AccountAPIMock{
+		HotspotsAllFunc: func(input *helium.AccountInput, opts ...helium.PagerOption) *helium.HotspotPager {
+			return helium.NewHotspotPager(func(ctx context.Context, cursor string) (*helium.Hotspots, error) {
+				return pages[cursor], nil
+			}, opts...)
+		},
+	}
+	api := &APIMock{
+		AccountFunc: func() helium.AccountAPI { return accounts },
+	}
+
+	names, err := ownerNames(context.Background(), api, heliumtest.RichAccount)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names)
+	if assert.Len(t, accounts.HotspotsAllCalls(), 1) {
+		assert.Equal(t, heliumtest.RichAccount, accounts.HotspotsAllCalls()[0].Input.ID)
+	}
+
+	accounts.HotspotsAllFunc = func(input *helium.AccountInput, opts ...helium.PagerOption) *helium.HotspotPager {
+		return helium.NewHotspotPager(func(ctx context.Context, cursor string) (*helium.Hotspots, error) {
+			return nil, helium.ErrNotFound
+		})
+	}
+	_, err = ownerNames(context.Background(), api, heliumtest.RichAccount)
+	assert.True(t, errors.Is(err, helium.ErrNotFound))
+}
+
+func TestClientAPI(t *testing.T) {
+	server := heliumtest.NewServer()
+	defer server.Close()
+
+	names, err := ownerNames(context.Background(), server.Client().API(), heliumtest.ActiveAccount)
+	assert.NoError(t, err)
+	assert.Len(t, names, 8)
+}
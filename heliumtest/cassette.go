@@ -0,0 +1,255 @@
+package heliumtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+
+	helium "github.com/dougkirkley/helium-go"
+)
+
+// CassetteEnv selects the Recorder mode used by ModeFromEnv, set it to "record" to capture new cassettes
+const CassetteEnv = "HELIUM_CASSETTE"
+
+// redacted replaces the value of sensitive request headers in cassettes
+const redacted = "REDACTED"
+
+// ErrUnmatchedRequest is returned by a replaying Recorder for a request that is not in its cassette
+var ErrUnmatchedRequest = errors.New("heliumtest: request not found in cassette")
+
+// unmatchedRequestError is the ErrUnmatchedRequest for one request, it is never retried
+type unmatchedRequestError struct {
+	message string
+}
+
+func (e *unmatchedRequestError) Error() string {
+	return e.message
+}
+
+// Is matches ErrUnmatchedRequest
+func (e *unmatchedRequestError) Is(target error) bool {
+	return target == ErrUnmatchedRequest
+}
+
+// Retryable reports false, replaying the same request can not find a different answer
+func (e *unmatchedRequestError) Retryable() bool {
+	return false
+}
+
+// Mode is whether a Recorder records or replays
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and never touches the network
+	ModeReplay Mode = iota
+	// ModeRecord makes real requests and records them to the cassette
+	ModeRecord
+)
+
+// ModeFromEnv returns ModeRecord when HELIUM_CASSETTE=record and ModeReplay otherwise
+func ModeFromEnv() Mode {
+	if os.Getenv(CassetteEnv) == "record" {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Cassette is the recorded interactions stored in a cassette file
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request used to match it on replay
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Query is the encoded query string with parameters sorted by key
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded response
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Recorder is an http.RoundTripper that records requests to a cassette file or replays them from it.
+// Requests match on method, path and sorted query parameters, identical requests replay in the order they were recorded.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewRecorder returns a Recorder for the cassette file at path. In ModeRecord requests are made with transport,
+// http.DefaultTransport when nil, and saved by Save. In ModeReplay the cassette must already exist.
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: transport,
+		cassette:  &Cassette{},
+	}
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("heliumtest: reading cassette: %w", err)
+	}
+	err = json.Unmarshal(data, r.cassette)
+	if err != nil {
+		return nil, fmt.Errorf("heliumtest: decoding cassette %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Client returns a helium.Client that makes its requests through the recorder
+func (r *Recorder) Client(opts ...helium.Option) *helium.Client {
+	// copy opts so the transport is never appended into the caller's array
+	return helium.ClientWithOptions(append(append([]helium.Option{}, opts...), helium.WithTransport(r))...)
+}
+
+// RoundTrip records or replays req
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == ModeRecord {
+		return r.record(req, recorded)
+	}
+	return r.replay(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       string(body),
+		},
+	})
+	r.mu.Unlock()
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !interaction.Request.matches(recorded) {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, &unmatchedRequestError{message: fmt.Sprintf("%v: %s %s?%s in %s", ErrUnmatchedRequest, recorded.Method, recorded.Path, recorded.Query, r.path)}
+}
+
+// Save writes the recorded interactions to the cassette file, it does nothing when replaying
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+// Unused returns the replayed interactions that were never requested
+func (r *Recorder) Unused() []*Interaction {
+	if r.mode == ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unused []*Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+func recordRequest(req *http.Request) (RecordedRequest, error) {
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		// Encode sorts by key so the random order of the client's params map does not matter
+		Query:  req.URL.Query().Encode(),
+		Header: req.Header.Clone(),
+	}
+	if len(recorded.Header.Get("key")) > 0 {
+		recorded.Header.Set("key", redacted)
+	}
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return recorded, err
+		}
+		defer body.Close()
+		data, err := ioutil.ReadAll(body)
+		if err != nil {
+			return recorded, err
+		}
+		recorded.Body = string(data)
+	}
+	return recorded, nil
+}
+
+func (r RecordedRequest) matches(other RecordedRequest) bool {
+	return r.Method == other.Method && r.Path == other.Path && r.Query == other.Query
+}
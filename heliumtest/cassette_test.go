@@ -0,0 +1,83 @@
+package heliumtest
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	helium "github.com/dougkirkley/helium-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	server := NewServer()
+	hotspot := server.Fixtures.Hotspots[0].Address
+	baseURL := server.URL
+
+	recorder, err := NewRecorder(path, ModeRecord, nil)
+	assert.NoError(t, err)
+	client := recorder.Client(helium.WithURL(baseURL), helium.WithKey("secret-key"))
+	challenges, err := client.Hotspot().Challenges(&helium.HotspotInput{Address: hotspot})
+	assert.NoError(t, err)
+	elected, err := client.Validator().ElectedAtHeight("900000")
+	assert.NoError(t, err)
+	rewards, err := client.Validator().Rewards(server.Fixtures.Validators[0].Address, "", "2021-06-01", "2021-05-01")
+	assert.NoError(t, err)
+	_, err = client.Hotspot().Get(&helium.HotspotInput{Address: Address("missing")})
+	assert.True(t, errors.Is(err, helium.ErrNotFound))
+	assert.NoError(t, recorder.Save())
+	server.Close()
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.False(t, strings.Contains(string(data), "secret-key"))
+
+	replayer, err := NewRecorder(path, ModeReplay, nil)
+	assert.NoError(t, err)
+	client = replayer.Client(helium.WithURL(baseURL), helium.WithKey("another-key"))
+	replayedChallenges, err := client.Hotspot().Challenges(&helium.HotspotInput{Address: hotspot})
+	assert.NoError(t, err)
+	assert.Equal(t, challenges, replayedChallenges)
+	replayedElected, err := client.Validator().ElectedAtHeight("900000")
+	assert.NoError(t, err)
+	assert.Equal(t, elected, replayedElected)
+	// the client's params map is unordered, matching uses the sorted query
+	replayedRewards, err := client.Validator().Rewards(server.Fixtures.Validators[0].Address, "", "2021-06-01", "2021-05-01")
+	assert.NoError(t, err)
+	assert.Equal(t, rewards, replayedRewards)
+	_, err = client.Hotspot().Get(&helium.HotspotInput{Address: Address("missing")})
+	assert.True(t, errors.Is(err, helium.ErrNotFound))
+	assert.Empty(t, replayer.Unused())
+
+	_, err = client.Validator().ElectedAtHeight("900001")
+	assert.True(t, errors.Is(err, ErrUnmatchedRequest))
+}
+
+func TestRecorderMissingCassette(t *testing.T) {
+	_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil)
+	assert.Error(t, err)
+}
+
+func TestRecorderUnmatchedNotRetried(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := NewRecorder(path, ModeRecord, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, recorder.Save())
+
+	replayer, err := NewRecorder(path, ModeReplay, nil)
+	assert.NoError(t, err)
+	// the option slice has spare capacity, Client must not write its transport into it
+	opts := make([]helium.Option, 1, 2)
+	opts[0] = helium.WithRetryPolicy(helium.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Minute, MaxBackoff: time.Minute})
+	client := replayer.Client(opts...)
+	assert.Nil(t, opts[:2][1])
+
+	start := time.Now()
+	_, err = client.Validator().ElectedAtHeight("900000")
+	assert.True(t, errors.Is(err, ErrUnmatchedRequest))
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
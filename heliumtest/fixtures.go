@@ -0,0 +1,347 @@
+package heliumtest
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	helium "github.com/dougkirkley/helium-go"
+	"github.com/dougkirkley/helium-go/internal/base58"
+)
+
+// Well known fixture addresses, each has data on the matching endpoints
+const (
+	// RichAccount owns hotspots and has activity
+	RichAccount helium.Address = "13WRNw4fmssJBvMqMnREwe1eCvUVXfnWXSXGcWXyVvAnQUF3D9R"
+	// OuiOwner owns a single OUI
+	OuiOwner helium.Address = "13tyMLKRFYURNBQqLSqNJg9k41maP1A7Bh8QYxR13oWv7EnFooc"
+	// ActiveAccount has activity and a pending transaction
+	ActiveAccount helium.Address = "13GCcF7oGb6waFBzYDMmydmXx4vNDUZGX4LE3QUh8eSBG53s5bx"
+	// QuietAccount has no hotspots, elections or activity
+	QuietAccount helium.Address = "146MwmL9eJJCdrykbgdL3dobdChP4Ut34mCZMR3Hv9HXTeBJQzC"
+)
+
+// Fixtures is the data served by a fake Server
+type Fixtures struct {
+	Accounts            []helium.AccountData
+	Hotspots            []helium.HotspotData
+	Blocks              []helium.BlockData
+	Transactions        map[int][]helium.Txn
+	Validators          []helium.ValidatorData
+	OraclePrices        []helium.OraclePriceData
+	Cities              []helium.CityData
+	Ouis                []helium.OuiData
+	Activity            map[helium.Address][]helium.ActivityData
+	PendingTransactions []helium.PendingTransactionData
+	Stats               helium.StatsData
+	Vars                helium.VarMap
+	StateChannels       []helium.StateChannelCloseV1
+	DCBurns             []helium.DCBurnData
+	Challenges          []helium.ChallengeData
+	Elections           []helium.ElectionData
+	// Rewards are the rewards transactions of the network, newest first
+	Rewards []helium.RewardsV2
+}
+
+// Address returns a valid, deterministic ed25519 mainnet address for seed
+func Address(seed string) helium.Address {
+	key := sha256.Sum256([]byte(seed))
+	return helium.Address(base58.CheckEncode(0, append([]byte{0x01}, key[:]...)))
+}
+
+// priceAt returns the oracle price in effect at height
+func (f *Fixtures) priceAt(height int) int {
+	// prices are newest first, the price at a height is the first one that took effect at or before it
+	for _, price := range f.OraclePrices {
+		if price.Block <= height {
+			return price.Price
+		}
+	}
+	return 0
+}
+
+// hash returns a deterministic transaction or block hash for seed
+func hash(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// DefaultFixtures returns a small but realistic chain: 150 accounts, 24 hotspots across 3 cities,
+// 50 blocks, 8 validators, 30 oracle prices and a pending payment
+func DefaultFixtures() *Fixtures {
+	f := &Fixtures{
+		Transactions: make(map[int][]helium.Txn),
+		Activity:     make(map[helium.Address][]helium.ActivityData),
+	}
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	height := 900000
+
+	f.Cities = []helium.CityData{
+		{CityID: "c2FuIGZyYW5jaXNjb2NhbGlmb3JuaWF1bml0ZWQgc3RhdGVz", LongCity: "San Francisco", LongState: "California", LongCountry: "United States", ShortCity: "San Francisco", ShortState: "CA", ShortCountry: "US"},
+		{CityID: "YXVzdGludGV4YXN1bml0ZWQgc3RhdGVz", LongCity: "Austin", LongState: "Texas", LongCountry: "United States", ShortCity: "Austin", ShortState: "TX", ShortCountry: "US"},
+		{CityID: "bG9uZG9uZW5nbGFuZHVuaXRlZCBraW5nZG9t", LongCity: "London", LongState: "England", LongCountry: "United Kingdom", ShortCity: "London", ShortState: "England", ShortCountry: "GB"},
+	}
+	coordinates := [][2]float64{{37.7749, -122.4194}, {30.2672, -97.7431}, {51.5072, -0.1276}}
+
+	f.Accounts = []helium.AccountData{
+		{Address: RichAccount, Balance: 250000000000, DcBalance: 1000000, Nonce: 12, Block: height},
+		{Address: OuiOwner, Balance: 1500000000, Nonce: 3, Block: height},
+		{Address: ActiveAccount, Balance: 4200000000, DcBalance: 5000, Nonce: 7, Block: height},
+		{Address: QuietAccount, Balance: 100000000, Block: height},
+	}
+	for i := len(f.Accounts); i < 150; i++ {
+		f.Accounts = append(f.Accounts, helium.AccountData{
+			Address: Address(fmt.Sprintf("account-%d", i)),
+			Balance: helium.HNT(150-i) * 10000000,
+			Nonce:   i % 5,
+			Block:   height,
+		})
+	}
+
+	animals := []string{"fox", "owl", "bear", "hawk", "wolf", "lynx", "seal", "crow"}
+	colors := []string{"amber", "brave", "calm", "dusty", "eager", "fancy", "gentle", "hidden"}
+	for i := 0; i < 24; i++ {
+		city := f.Cities[i%len(f.Cities)]
+		owner := RichAccount
+		if i%3 == 2 {
+			owner = ActiveAccount
+		}
+		lat := coordinates[i%len(coordinates)][0] + float64(i)*0.001
+		lng := coordinates[i%len(coordinates)][1] + float64(i)*0.001
+		f.Hotspots = append(f.Hotspots, helium.HotspotData{
+			Address:    Address(fmt.Sprintf("hotspot-%d", i)),
+			Name:       fmt.Sprintf("%s-%s-%s", colors[i%len(colors)], colors[(i+3)%len(colors)], animals[i%len(animals)]),
+			Owner:      owner,
+			Lat:        lat,
+			Lng:        lng,
+			Location:   fmt.Sprintf("8c2830828%07x", i),
+			Block:      height,
+			BlockAdded: height - 50000 + i*100,
+			Nonce:      1,
+			Score:      0.25,
+			Status:     helium.Status{Online: "online", Height: height},
+			Geocode: helium.Geocode{
+				CityID:       city.CityID,
+				LongCity:     city.LongCity,
+				LongState:    city.LongState,
+				LongCountry:  city.LongCountry,
+				ShortCity:    city.ShortCity,
+				ShortState:   city.ShortState,
+				ShortCountry: city.ShortCountry,
+			},
+		})
+		f.Cities[i%len(f.Cities)].HotspotCount++
+	}
+
+	for i := 0; i < 50; i++ {
+		h := height - i
+		block := helium.BlockData{
+			Height:           h,
+			Hash:             hash(fmt.Sprintf("block-%d", h)),
+			PrevHash:         hash(fmt.Sprintf("block-%d", h-1)),
+			Time:             helium.Unix(start.Add(time.Duration(-i) * time.Minute).Unix()),
+			TransactionCount: 2,
+		}
+		f.Blocks = append(f.Blocks, block)
+		hotspot := f.Hotspots[i%len(f.Hotspots)]
+		f.Transactions[h] = []helium.Txn{
+			&helium.PocRequestV1{
+				TxnHeader:          helium.TxnHeader{Type: helium.TxnPocRequestV1, Hash: hash(fmt.Sprintf("poc-%d", h)), Height: h, Time: block.Time},
+				Challenger:         hotspot.Address,
+				ChallengerOwner:    hotspot.Owner,
+				ChallengerLocation: hotspot.Location,
+				Version:            2,
+			},
+			&helium.AssertLocationV1{
+				TxnHeader: helium.TxnHeader{Type: helium.TxnAssertLocationV1, Hash: hash(fmt.Sprintf("assert-%d", h)), Height: h, Time: block.Time},
+				Gateway:   hotspot.Address,
+				Owner:     hotspot.Owner,
+				Payer:     hotspot.Owner,
+				Location:  hotspot.Location,
+				Lat:       hotspot.Lat,
+				Lng:       hotspot.Lng,
+				Fee:       100000,
+			},
+		}
+	}
+
+	for i := 0; i < 8; i++ {
+		f.Validators = append(f.Validators, helium.ValidatorData{
+			Address:     Address(fmt.Sprintf("validator-%d", i)),
+			Owner:       RichAccount,
+			Name:        fmt.Sprintf("%s-%s-%s", colors[(i+1)%len(colors)], colors[(i+5)%len(colors)], animals[(i+2)%len(animals)]),
+			Stake:       1000000000000,
+			StakeStatus: "staked",
+			Status:      helium.Status{Online: "online", Height: height},
+			Block:       height,
+			BlockAdded:  height - 10000,
+			Penalties:   []interface{}{},
+		})
+	}
+
+	for i := 0; i < 30; i++ {
+		f.OraclePrices = append(f.OraclePrices, helium.OraclePriceData{
+			Block: height - i*60,
+			Price: 1500000000 + (i%7)*10000000,
+		})
+	}
+
+	f.Ouis = []helium.OuiData{
+		{Oui: 1, Owner: OuiOwner, Nonce: 1, Block: height - 400000, Addresses: []helium.Address{Address("router-1")}, Subnets: []helium.Subnets{{Base: 0, Mask: 8388606}}},
+		{Oui: 2, Owner: RichAccount, Nonce: 1, Block: height - 300000, Addresses: []helium.Address{Address("router-2")}, Subnets: []helium.Subnets{{Base: 8, Mask: 8388606}}},
+	}
+
+	f.Activity[ActiveAccount] = []helium.ActivityData{
+		{Type: "rewards_v2", Hash: hash("rewards-1"), Height: height - 30, Time: helium.Unix(start.Add(-30 * time.Minute).Unix()), StartEpoch: height - 60, EndEpoch: height - 31,
+			Rewards: []helium.RewardEntry{{Type: "poc_witnesses", Account: ActiveAccount, Gateway: f.Hotspots[2].Address, Amount: 1250000}}},
+		{Type: "rewards_v2", Hash: hash("rewards-2"), Height: height - 60, Time: helium.Unix(start.Add(-60 * time.Minute).Unix()), StartEpoch: height - 90, EndEpoch: height - 61,
+			Rewards: []helium.RewardEntry{{Type: "poc_challengees", Account: ActiveAccount, Gateway: f.Hotspots[5].Address, Amount: 3400000}}},
+	}
+	f.Activity[RichAccount] = f.Activity[ActiveAccount]
+
+	// a rewards transaction every 12 hours, two on each of the two days before start
+	for i := 0; i < 4; i++ {
+		at := start.Add(-time.Duration(1+12*i) * time.Hour)
+		epoch := height - 30 - 720*i
+		f.Rewards = append(f.Rewards, helium.RewardsV2{
+			TxnHeader:  helium.TxnHeader{Type: helium.TxnRewardsV2, Hash: hash(fmt.Sprintf("network-rewards-%d", i)), Height: epoch, Time: helium.Unix(at.Unix())},
+			StartEpoch: epoch - 30,
+			EndEpoch:   epoch - 1,
+			Rewards: []helium.RewardEntry{
+				{Type: "poc_witnesses", Account: ActiveAccount, Gateway: f.Hotspots[2].Address, Amount: helium.HNT(125000000)},
+				{Type: "poc_challengees", Account: RichAccount, Gateway: f.Hotspots[5].Address, Amount: helium.HNT(25000000 * (i + 1))},
+			},
+		})
+	}
+
+	f.PendingTransactions = []helium.PendingTransactionData{
+		{
+			Hash:      hash("pending-1"),
+			Type:      helium.TxnPaymentV2,
+			Status:    "pending",
+			CreatedAt: helium.Timestamp{Time: start},
+			UpdatedAt: helium.Timestamp{Time: start},
+			Txn: &helium.PaymentV2{
+				Payer:    ActiveAccount,
+				Nonce:    8,
+				Fee:      35000,
+				Payments: []helium.Payments{{Payee: RichAccount, Amount: 100000000}},
+			},
+		},
+	}
+
+	f.Stats = helium.StatsData{
+		Counts: helium.Counts{
+			Blocks:       height,
+			Hotspots:     len(f.Hotspots),
+			Cities:       len(f.Cities),
+			Countries:    2,
+			Transactions: 2 * len(f.Blocks),
+		},
+		ChallengeCounts: helium.ChallengeCounts{Active: 2, LastDay: 6},
+		TokenSupply:     95000000.5,
+	}
+
+	// one state channel closed every 10 blocks, newest first, with traffic from the first 3 hotspots
+	for i := 0; i < 5; i++ {
+		h := height - 10*i
+		var summaries []helium.StateChannelSummary
+		for j, hotspot := range f.Hotspots[:3] {
+			summaries = append(summaries, helium.StateChannelSummary{Client: hotspot.Address, Owner: hotspot.Owner, NumPackets: 10 * (j + 1), NumDcs: helium.DC(10 * (j + 1))})
+		}
+		f.StateChannels = append(f.StateChannels, helium.StateChannelCloseV1{
+			TxnHeader: helium.TxnHeader{Type: helium.TxnStateChannelCloseV1, Hash: hash(fmt.Sprintf("sc-close-%d", h)), Height: h, Time: helium.Unix(start.Add(time.Duration(-10*i) * time.Minute).Unix())},
+			Closer:    f.Ouis[0].Addresses[0],
+			StateChannel: helium.StateChannelData{
+				ID:            hash(fmt.Sprintf("sc-%d", h)),
+				Owner:         OuiOwner,
+				ExpireAtBlock: h,
+				State:         "closed",
+				Summaries:     summaries,
+			},
+		})
+	}
+
+	// each state channel close burns the data credits its hotspots were paid
+	for _, closed := range f.StateChannels {
+		f.DCBurns = append(f.DCBurns, helium.DCBurnData{
+			Block:           closed.Height,
+			TransactionHash: closed.Hash,
+			Actor:           closed.StateChannel.Owner,
+			Type:            helium.BurnStateChannel,
+			Amount:          closed.Traffic().NumDcs,
+			OraclePrice:     f.priceAt(closed.Height),
+			Time:            closed.Time,
+		})
+	}
+
+	// a challenge every 5 blocks, newest first, each hotspot challenging the next with the one after witnessing
+	for i := 0; i < 6; i++ {
+		h := height - 5*i
+		challenger, challengee, witness := f.Hotspots[i], f.Hotspots[i+1], f.Hotspots[i+2]
+		f.Challenges = append(f.Challenges, helium.ChallengeData{
+			Type:               string(helium.TxnPocReceiptsV1),
+			Hash:               hash(fmt.Sprintf("challenge-%d", h)),
+			Height:             h,
+			Time:               helium.Unix(start.Add(time.Duration(-5*i) * time.Minute).Unix()),
+			Challenger:         challenger.Address,
+			ChallengerOwner:    challenger.Owner,
+			ChallengerLocation: challenger.Location,
+			ChallengerLat:      challenger.Lat,
+			ChallengerLon:      challenger.Lng,
+			Path: []helium.Path{{
+				Challengee:         challengee.Address,
+				ChallengeeOwner:    challengee.Owner,
+				ChallengeeLocation: challengee.Location,
+				ChallengeeLat:      challengee.Lat,
+				ChallengeeLon:      challengee.Lng,
+				Witnesses: []helium.Witness{{
+					Gateway:   witness.Address,
+					Owner:     witness.Owner,
+					Location:  witness.Location,
+					IsValid:   true,
+					Signal:    -110,
+					Snr:       5,
+					Frequency: 904.1,
+					Timestamp: helium.UnixNano(start.Add(time.Duration(-5*i) * time.Minute).UnixNano()),
+				}},
+			}},
+		})
+	}
+
+	// an election every 30 blocks, newest first, of every validator
+	var members []helium.Address
+	for _, validator := range f.Validators {
+		members = append(members, validator.Address)
+	}
+	for i := 0; i < 3; i++ {
+		h := height - 30*i
+		f.Elections = append(f.Elections, helium.ElectionData{
+			Type:    string(helium.TxnConsensusGroupV1),
+			Hash:    hash(fmt.Sprintf("election-%d", h)),
+			Height:  h,
+			Time:    helium.Unix(start.Add(time.Duration(-30*i) * time.Minute).Unix()),
+			Members: members,
+			Delay:   5,
+		})
+	}
+
+	// binary vars are base64 strings, the oracle keys are each prefixed with their length
+	var oracleKeys []byte
+	for i := 0; i < 3; i++ {
+		key := Address(fmt.Sprintf("oracle-%d", i)).Bytes()
+		oracleKeys = append(append(oracleKeys, byte(len(key))), key...)
+	}
+	f.Vars = helium.VarMap{
+		"poc_challenge_interval":   {Name: "poc_challenge_interval", Kind: helium.VarInt, Int: 480},
+		"txn_fee_multiplier":       {Name: "txn_fee_multiplier", Kind: helium.VarInt, Int: 5000},
+		"validator_minimum_stake":  {Name: "validator_minimum_stake", Kind: helium.VarInt, Int: 1000000000000},
+		"dc_payload_size":          {Name: "dc_payload_size", Kind: helium.VarInt, Int: 24},
+		"poc_v4_exclusion_cells":   {Name: "poc_v4_exclusion_cells", Kind: helium.VarInt, Int: 8},
+		"dc_percent":               {Name: "dc_percent", Kind: helium.VarFloat, Float: 0.325},
+		"predicate_callback_mod":   {Name: "predicate_callback_mod", Kind: helium.VarString, String: "miner"},
+		"price_oracle_public_keys": {Name: "price_oracle_public_keys", Kind: helium.VarString, String: base64.StdEncoding.EncodeToString(oracleKeys)},
+	}
+	return f
+}
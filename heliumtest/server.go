@@ -0,0 +1,873 @@
+// Package heliumtest provides an in-process fake of the Helium API for testing code that uses helium.Client
+// without network access.
+//
+//	server := heliumtest.NewServer()
+//	defer server.Close()
+//	client := server.Client()
+//	accounts, err := client.Account().List(&helium.AccountListInput{})
+package heliumtest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	helium "github.com/dougkirkley/helium-go"
+)
+
+// DefaultPageSize is the number of items per page, matching the api's account pages
+const DefaultPageSize = 100
+
+// Server is a fake Helium API serving Fixtures
+type Server struct {
+	*httptest.Server
+	Fixtures *Fixtures
+	// PageSize is the number of items returned per page of a paginated endpoint
+	PageSize int
+
+	mu        sync.Mutex
+	failures  []*failure
+	requests  int
+	submitted []string
+}
+
+// failure is an injected error response
+type failure struct {
+	prefix     string
+	status     int
+	remaining  int
+	retryAfter string
+}
+
+// NewServer starts a fake api serving DefaultFixtures
+func NewServer() *Server {
+	return NewServerWithFixtures(DefaultFixtures())
+}
+
+// NewServerWithFixtures starts a fake api serving f
+func NewServerWithFixtures(f *Fixtures) *Server {
+	s := &Server{
+		Fixtures: f,
+		PageSize: DefaultPageSize,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a helium.Client for the fake api
+func (s *Server) Client(opts ...helium.Option) *helium.Client {
+	return helium.ClientWithOptions(append([]helium.Option{helium.WithURL(s.URL)}, opts...)...)
+}
+
+// Fail makes the next times requests to paths starting with prefix fail with status,
+// times <= 0 fails every request until ClearFailures is called
+func (s *Server) Fail(prefix string, status int, times int) {
+	s.FailRetryAfter(prefix, status, times, "")
+}
+
+// FailRetryAfter is Fail with a Retry-After header on the failed responses
+func (s *Server) FailRetryAfter(prefix string, status int, times int, retryAfter string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, &failure{prefix: prefix, status: status, remaining: times, retryAfter: retryAfter})
+}
+
+// ClearFailures removes all injected failures
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+}
+
+// Requests returns the number of requests served, including failed ones
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Submitted returns the base64 transactions posted to /pending_transactions
+func (s *Server) Submitted() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.submitted...)
+}
+
+// injectedFailure returns the failure to respond with for path, if any
+func (s *Server) injectedFailure(path string) *failure {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	for i, f := range s.failures {
+		if !strings.HasPrefix(path, f.prefix) {
+			continue
+		}
+		if f.remaining > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if f := s.injectedFailure(r.URL.Path); f != nil {
+		if len(f.retryAfter) > 0 {
+			w.Header().Set("Retry-After", f.retryAfter)
+		}
+		writeError(w, f.status, http.StatusText(f.status))
+		return
+	}
+	if r.Method == http.MethodPost && r.URL.Path == "/pending_transactions" {
+		s.submit(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	var handled bool
+	switch segments[0] {
+	case "accounts":
+		handled = s.accounts(w, r, segments[1:])
+	case "hotspots":
+		handled = s.hotspots(w, r, segments[1:])
+	case "blocks":
+		handled = s.blocks(w, r, segments[1:])
+	case "validators":
+		handled = s.validators(w, r, segments[1:])
+	case "oracle":
+		handled = s.oracle(w, r, segments[1:])
+	case "cities":
+		handled = s.cities(w, r, segments[1:])
+	case "pending_transactions":
+		handled = s.pendingTransactions(w, r, segments[1:])
+	case "stats":
+		handled = s.stats(w, r, segments[1:])
+	case "rewards":
+		handled = s.rewards(w, r, segments[1:])
+	case "vars":
+		handled = s.vars(w, r, segments[1:])
+	case "ouis":
+		handled = s.ouis(w, r, segments[1:])
+	case "state_channels":
+		handled = s.stateChannels(w, r, segments[1:])
+	case "dc_burns":
+		handled = s.dcBurns(w, r, segments[1:])
+	case "challenges":
+		handled = s.challenges(w, r, segments[1:])
+	case "elections":
+		handled = s.elections(w, r, segments[1:])
+	}
+	if !handled {
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) accounts(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	switch {
+	case len(segments) == 0:
+		start, end, cursor := s.page(r, len(f.Accounts))
+		writePage(w, f.Accounts[start:end], cursor)
+	case len(segments) == 1 && segments[0] == "rich":
+		accounts := append([]helium.AccountData(nil), f.Accounts...)
+		sort.SliceStable(accounts, func(i, j int) bool { return accounts[i].Balance > accounts[j].Balance })
+		limit := 100
+		if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 && l < limit {
+			limit = l
+		}
+		if limit > len(accounts) {
+			limit = len(accounts)
+		}
+		writeData(w, accounts[:limit])
+	default:
+		address := helium.Address(segments[0])
+		account, ok := f.account(address)
+		if !ok {
+			return false
+		}
+		if len(segments) == 1 {
+			writeData(w, account)
+			return true
+		}
+		switch strings.Join(segments[1:], "/") {
+		case "hotspots":
+			hotspots := f.hotspotsWhere(func(h helium.HotspotData) bool { return h.Owner == address })
+			start, end, cursor := s.page(r, len(hotspots))
+			writePage(w, hotspots[start:end], cursor)
+		case "ouis":
+			var ouis []helium.OuiData
+			for _, oui := range f.Ouis {
+				if oui.Owner == address {
+					ouis = append(ouis, oui)
+				}
+			}
+			start, end, cursor := s.page(r, len(ouis))
+			writePage(w, ouis[start:end], cursor)
+		case "activity":
+			activity := f.activity(address, r)
+			start, end, cursor := s.page(r, len(activity))
+			writePage(w, activity[start:end], cursor)
+		case "roles":
+			var roles []helium.RoleData
+			for _, activity := range f.activity(address, r) {
+				roles = append(roles, helium.RoleData{Type: helium.TxnType(activity.Type), Time: activity.Time, Role: "payee", Height: activity.Height, Hash: activity.Hash})
+			}
+			start, end, cursor := s.page(r, len(roles))
+			writePage(w, roles[start:end], cursor)
+		case "activity/count":
+			counts := make(map[string]int)
+			for _, activity := range f.Activity[address] {
+				counts[activity.Type]++
+			}
+			writeData(w, counts)
+		case "elections", "challenges", "rewards":
+			writePage(w, []struct{}{}, "")
+		case "pending_transactions":
+			var pending []helium.PendingTransactionData
+			for _, txn := range f.PendingTransactions {
+				if payment, ok := txn.Txn.(*helium.PaymentV2); ok && payment.Payer == address {
+					pending = append(pending, txn)
+				}
+			}
+			start, end, cursor := s.page(r, len(pending))
+			writePage(w, pending[start:end], cursor)
+		case "rewards/sum":
+			writeSum(w, r, map[string]interface{}{"sum": "0", "min_time": nil, "max_time": nil})
+		case "stats":
+			writeData(w, helium.AccountStatsData{LastDay: []helium.LastDay{}, LastWeek: []helium.LastWeek{}, LastMonth: []helium.LastMonth{}})
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) hotspots(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	query := r.URL.Query()
+	switch strings.Join(segments, "/") {
+	case "":
+		start, end, cursor := s.page(r, len(f.Hotspots))
+		writePage(w, f.Hotspots[start:end], cursor)
+		return true
+	case "name":
+		term := strings.ToLower(query.Get("search"))
+		writeData(w, f.hotspotsWhere(func(h helium.HotspotData) bool { return strings.Contains(h.Name, term) }))
+		return true
+	case "elected":
+		writeData(w, []struct{}{})
+		return true
+	case "location/distance":
+		lat, _ := strconv.ParseFloat(query.Get("lat"), 64)
+		lon, _ := strconv.ParseFloat(query.Get("lon"), 64)
+		distance, _ := strconv.ParseFloat(query.Get("distance"), 64)
+		hotspots := f.hotspotsWhere(func(h helium.HotspotData) bool { return haversine(lat, lon, h.Lat, h.Lng) <= distance })
+		start, end, cursor := s.page(r, len(hotspots))
+		writePage(w, hotspots[start:end], cursor)
+		return true
+	case "location/box":
+		swlat, _ := strconv.ParseFloat(query.Get("swlat"), 64)
+		swlon, _ := strconv.ParseFloat(query.Get("swlon"), 64)
+		nelat, _ := strconv.ParseFloat(query.Get("nelat"), 64)
+		nelon, _ := strconv.ParseFloat(query.Get("nelon"), 64)
+		hotspots := f.hotspotsWhere(func(h helium.HotspotData) bool {
+			return h.Lat >= swlat && h.Lat <= nelat && h.Lng >= swlon && h.Lng <= nelon
+		})
+		start, end, cursor := s.page(r, len(hotspots))
+		writePage(w, hotspots[start:end], cursor)
+		return true
+	}
+
+	if len(segments) == 2 && segments[0] == "name" {
+		writeData(w, f.hotspotsWhere(func(h helium.HotspotData) bool { return h.Name == segments[1] }))
+		return true
+	}
+	if len(segments) == 2 && segments[0] == "hex" {
+		hotspots := f.hotspotsWhere(func(h helium.HotspotData) bool { return h.Location == segments[1] })
+		if len(hotspots) == 0 {
+			return false
+		}
+		writeData(w, hotspots[0])
+		return true
+	}
+
+	hotspots := f.hotspotsWhere(func(h helium.HotspotData) bool { return h.Address == helium.Address(segments[0]) })
+	if len(hotspots) == 0 {
+		return false
+	}
+	switch strings.Join(segments[1:], "/") {
+	case "":
+		writeData(w, hotspots[0])
+	case "activity", "roles", "elections", "challenges", "rewards":
+		writePage(w, []struct{}{}, "")
+	case "activity/count":
+		writeData(w, map[string]int{})
+	case "rewards/sum":
+		writeSum(w, r, map[string]interface{}{"sum": "0", "min_time": nil, "max_time": nil})
+	case "witnesses":
+		writeData(w, f.witnesses(hotspots[0].Address, true))
+	case "witnessed":
+		writeData(w, f.witnesses(hotspots[0].Address, false))
+	default:
+		return false
+	}
+	return true
+}
+
+// witnesses aggregates the witness reports of the challenges into the hotspots that witnessed address's beacons,
+// or with of false the hotspots whose beacons address witnessed
+func (f *Fixtures) witnesses(address helium.Address, of bool) []helium.WitnessData {
+	neighbours := make(map[helium.Address]*helium.WitnessInfo)
+	for _, challenge := range f.Challenges {
+		for _, path := range challenge.Path {
+			for _, witness := range path.Witnesses {
+				neighbour := witness.Gateway
+				if !of {
+					neighbour = path.Challengee
+				}
+				if neighbour == address || (of && path.Challengee != address) || (!of && witness.Gateway != address) {
+					continue
+				}
+				info, ok := neighbours[neighbour]
+				if !ok {
+					info = &helium.WitnessInfo{Histogram: helium.Histogram{}, SnrHistogram: helium.Histogram{}, FirstTime: witness.Timestamp, RecentTime: witness.Timestamp}
+					neighbours[neighbour] = info
+				}
+				info.Histogram[witness.Signal]++
+				info.SnrHistogram[witness.Snr]++
+				if witness.Timestamp.Before(info.FirstTime.Time) {
+					info.FirstTime = witness.Timestamp
+				}
+				if witness.Timestamp.After(info.RecentTime.Time) {
+					info.RecentTime = witness.Timestamp
+				}
+			}
+		}
+	}
+
+	witnesses := []helium.WitnessData{}
+	for _, hotspot := range f.Hotspots {
+		info, ok := neighbours[hotspot.Address]
+		if !ok {
+			continue
+		}
+		witnesses = append(witnesses, helium.WitnessData{
+			Address:     hotspot.Address,
+			Block:       hotspot.Block,
+			BlockAdded:  hotspot.BlockAdded,
+			Geocode:     hotspot.Geocode,
+			Lat:         hotspot.Lat,
+			Lng:         hotspot.Lng,
+			Location:    hotspot.Location,
+			Name:        hotspot.Name,
+			Nonce:       hotspot.Nonce,
+			Owner:       hotspot.Owner,
+			Score:       hotspot.Score,
+			Status:      hotspot.Status,
+			WitnessFor:  address,
+			WitnessInfo: *info,
+		})
+	}
+	return witnesses
+}
+
+func (s *Server) blocks(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	switch {
+	case len(segments) == 0:
+		start, end, cursor := s.page(r, len(f.Blocks))
+		writePage(w, f.Blocks[start:end], cursor)
+	case len(segments) == 1 && segments[0] == "height":
+		height := 0
+		if len(f.Blocks) > 0 {
+			height = f.Blocks[0].Height
+		}
+		writeData(w, helium.HeightData{Height: height})
+	case len(segments) == 1 && segments[0] == "stats":
+		writeData(w, helium.BlockStatsData{LastHour: helium.LastHour{Avg: 60, Stddev: 5}})
+	default:
+		block, ok := f.block(segments[0])
+		if !ok {
+			return false
+		}
+		switch strings.Join(segments[1:], "/") {
+		case "", "height":
+			writeData(w, block)
+		case "transactions":
+			transactions := f.Transactions[block.Height]
+			start, end, cursor := s.page(r, len(transactions))
+			writePage(w, transactions[start:end], cursor)
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) validators(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	switch strings.Join(segments, "/") {
+	case "":
+		start, end, cursor := s.page(r, len(f.Validators))
+		writePage(w, f.Validators[start:end], cursor)
+		return true
+	case "stats":
+		writeData(w, helium.ValidatorStatsData{Active: len(f.Validators), Staked: helium.Staked{Count: len(f.Validators)}})
+		return true
+	case "elected":
+		var elected []helium.ValidatorElectionData
+		for _, validator := range f.Validators {
+			elected = append(elected, helium.ValidatorElectionData{Address: validator.Address, Owner: validator.Owner, Stake: validator.Stake, Block: validator.Block})
+		}
+		writeData(w, elected)
+		return true
+	case "name":
+		term := strings.ToLower(r.URL.Query().Get("search"))
+		var validators []helium.ValidatorData
+		for _, validator := range f.Validators {
+			if strings.Contains(validator.Name, term) {
+				validators = append(validators, validator)
+			}
+		}
+		writeData(w, validators)
+		return true
+	}
+	if len(segments) >= 2 && segments[0] == "elected" {
+		writeData(w, f.Validators)
+		return true
+	}
+	if len(segments) == 2 && segments[0] == "name" {
+		for _, validator := range f.Validators {
+			if validator.Name == segments[1] {
+				writeData(w, validator)
+				return true
+			}
+		}
+		return false
+	}
+
+	var validator *helium.ValidatorData
+	for i := range f.Validators {
+		if f.Validators[i].Address == helium.Address(segments[0]) {
+			validator = &f.Validators[i]
+		}
+	}
+	if validator == nil {
+		return false
+	}
+	switch strings.Join(segments[1:], "/") {
+	case "":
+		writeData(w, validator)
+	case "activity", "roles", "rewards":
+		writePage(w, []struct{}{}, "")
+	case "activity/count":
+		writeData(w, map[string]int{})
+	case "rewards/sum":
+		writeSum(w, r, helium.ValidatorRewardsSumData{})
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) oracle(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	if len(segments) == 0 || segments[0] != "prices" {
+		return false
+	}
+	switch strings.Join(segments[1:], "/") {
+	case "":
+		start, end, cursor := s.page(r, len(f.OraclePrices))
+		writePage(w, f.OraclePrices[start:end], cursor)
+	case "current":
+		if len(f.OraclePrices) == 0 {
+			return false
+		}
+		writeData(w, f.OraclePrices[0])
+	case "stats":
+		writeData(w, helium.OraclePriceStatsData{})
+	case "activity":
+		writePage(w, []struct{}{}, "")
+	default:
+		height, err := strconv.Atoi(segments[1])
+		if err != nil {
+			return false
+		}
+		// prices are newest first, the price at a height is the first one that took effect at or before it
+		for _, price := range f.OraclePrices {
+			if price.Block <= height {
+				writeData(w, price)
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (s *Server) cities(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	switch {
+	case len(segments) == 0:
+		term := strings.ToLower(r.URL.Query().Get("search"))
+		var cities []helium.CityData
+		for _, city := range f.Cities {
+			if strings.Contains(strings.ToLower(city.LongCity), term) {
+				cities = append(cities, city)
+			}
+		}
+		start, end, cursor := s.page(r, len(cities))
+		writePage(w, cities[start:end], cursor)
+	case len(segments) == 2 && segments[1] == "hotspots":
+		hotspots := f.hotspotsWhere(func(h helium.HotspotData) bool { return h.Geocode.CityID == segments[0] })
+		start, end, cursor := s.page(r, len(hotspots))
+		writePage(w, hotspots[start:end], cursor)
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) pendingTransactions(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) != 1 {
+		return false
+	}
+	for _, txn := range s.Fixtures.PendingTransactions {
+		if txn.Hash == segments[0] {
+			writeData(w, []helium.PendingTransactionData{txn})
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) submit(w http.ResponseWriter, r *http.Request) {
+	var body helium.TransactionSubmitBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err != nil || len(body.Txn) == 0 {
+		writeError(w, http.StatusBadRequest, "invalid transaction")
+		return
+	}
+	s.mu.Lock()
+	s.submitted = append(s.submitted, body.Txn)
+	s.mu.Unlock()
+	writeData(w, helium.SubmittedHashData{Hash: hash(body.Txn)})
+}
+
+func (s *Server) stats(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	switch strings.Join(segments, "/") {
+	case "":
+		writeData(w, s.Fixtures.Stats)
+	case "token_supply":
+		writeData(w, helium.TokenSupplyData{TokenSupply: s.Fixtures.Stats.TokenSupply})
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) vars(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	switch {
+	case len(segments) == 0:
+		writeData(w, s.Fixtures.Vars)
+	case len(segments) == 1 && segments[0] == "activity":
+		writePage(w, []struct{}{}, "")
+	case len(segments) == 1:
+		v, ok := s.Fixtures.Vars[segments[0]]
+		if !ok {
+			return false
+		}
+		writeData(w, v)
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) ouis(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	switch {
+	case len(segments) == 0:
+		start, end, cursor := s.page(r, len(f.Ouis))
+		writePage(w, f.Ouis[start:end], cursor)
+	case len(segments) != 1:
+		return false
+	case segments[0] == "stats":
+		writeData(w, helium.OuiStatsData{Count: len(f.Ouis)})
+	case segments[0] == "last":
+		if len(f.Ouis) == 0 {
+			return false
+		}
+		writeData(w, f.Ouis[len(f.Ouis)-1])
+	default:
+		number, err := strconv.Atoi(segments[0])
+		if err != nil {
+			return false
+		}
+		for _, oui := range f.Ouis {
+			if oui.Oui == number {
+				writeData(w, oui)
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (s *Server) stateChannels(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	switch strings.Join(segments, "/") {
+	case "":
+		start, end, cursor := s.page(r, len(f.StateChannels))
+		writePage(w, f.StateChannels[start:end], cursor)
+	case "stats":
+		var stats helium.StateChannelStatsData
+		for _, closed := range f.StateChannels {
+			traffic := closed.Traffic()
+			stats.LastMonth.NumPackets += traffic.NumPackets
+			stats.LastMonth.NumDcs += traffic.NumDcs
+		}
+		stats.LastWeek = stats.LastMonth
+		stats.LastDay = stats.LastMonth
+		writeData(w, stats)
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) dcBurns(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	var totals helium.DCBurnTotals
+	for _, burn := range f.DCBurns {
+		switch burn.Type {
+		case helium.BurnAddGateway:
+			totals.AddGateway += burn.Amount
+		case helium.BurnAssertLocation:
+			totals.AssertLocation += burn.Amount
+		case helium.BurnFee:
+			totals.Fee += burn.Amount
+		case helium.BurnOui:
+			totals.Oui += burn.Amount
+		case helium.BurnRouting:
+			totals.Routing += burn.Amount
+		case helium.BurnStateChannel:
+			totals.StateChannel += burn.Amount
+		}
+	}
+	switch strings.Join(segments, "/") {
+	case "":
+		start, end, cursor := s.page(r, len(f.DCBurns))
+		writePage(w, f.DCBurns[start:end], cursor)
+	case "sum":
+		writeSum(w, r, totals)
+	case "stats":
+		writeData(w, helium.DCBurnStatsData{LastDay: totals, LastWeek: totals, LastMonth: totals})
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) challenges(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	switch strings.Join(segments, "/") {
+	case "":
+		start, end, cursor := s.page(r, len(f.Challenges))
+		writePage(w, f.Challenges[start:end], cursor)
+	case "stats":
+		writeData(w, f.Stats.ChallengeCounts)
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) elections(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	if len(segments) != 0 {
+		return false
+	}
+	start, end, cursor := s.page(r, len(s.Fixtures.Elections))
+	writePage(w, s.Fixtures.Elections[start:end], cursor)
+	return true
+}
+
+// page returns the bounds of the page requested by r's cursor and the cursor for the page after it
+func (s *Server) page(r *http.Request, total int) (int, int, string) {
+	start := 0
+	if cursor := r.URL.Query().Get("cursor"); len(cursor) > 0 {
+		decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err == nil {
+			fmt.Sscanf(string(decoded), "offset:%d", &start)
+		}
+	}
+	if start > total || start < 0 {
+		start = total
+	}
+	size := s.PageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	end := start + size
+	if end >= total {
+		return start, total, ""
+	}
+	return start, end, base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("offset:%d", end)))
+}
+
+func (s *Server) rewards(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	f := s.Fixtures
+	switch strings.Join(segments, "/") {
+	case "":
+		start, end, cursor := s.page(r, len(f.Rewards))
+		writePage(w, f.Rewards[start:end], cursor)
+	case "sum":
+		bucket := helium.Bucket(r.URL.Query().Get("bucket"))
+		if len(bucket) == 0 {
+			var sum helium.HNT
+			for _, rewards := range f.Rewards {
+				sum += rewardsSum(rewards)
+			}
+			writeData(w, helium.RewardStatsData{Sum: sum, Total: sum.Float64()})
+			return true
+		}
+		size := map[helium.Bucket]time.Duration{helium.BucketHour: time.Hour, helium.BucketDay: 24 * time.Hour, helium.BucketWeek: 7 * 24 * time.Hour}[bucket]
+		if size == 0 {
+			writeError(w, http.StatusBadRequest, "invalid bucket")
+			return true
+		}
+		// rewards are newest first, so are their buckets
+		var buckets []helium.RewardBucket
+		for _, rewards := range f.Rewards {
+			at := rewards.TxnTime().Truncate(size)
+			if len(buckets) == 0 || !buckets[len(buckets)-1].Timestamp.Equal(at) {
+				buckets = append(buckets, helium.RewardBucket{Timestamp: helium.Unix(at.Unix())})
+			}
+			last := &buckets[len(buckets)-1]
+			last.Sum += rewardsSum(rewards)
+			last.Total = last.Sum.Float64()
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": buckets, "meta": helium.Meta{Bucket: bucket}})
+	default:
+		return false
+	}
+	return true
+}
+
+// rewardsSum returns the HNT paid by a rewards transaction
+func rewardsSum(rewards helium.RewardsV2) helium.HNT {
+	var sum helium.HNT
+	for _, reward := range rewards.Rewards {
+		sum += reward.Amount
+	}
+	return sum
+}
+
+// activity returns the activity of an account, only of the types in the filter_types param when it is set
+func (f *Fixtures) activity(address helium.Address, r *http.Request) []helium.ActivityData {
+	filter := r.URL.Query().Get("filter_types")
+	if filter == "" {
+		return f.Activity[address]
+	}
+	types := make(map[string]bool)
+	for _, t := range strings.Split(filter, ",") {
+		types[t] = true
+	}
+	var activity []helium.ActivityData
+	for _, a := range f.Activity[address] {
+		if types[a.Type] {
+			activity = append(activity, a)
+		}
+	}
+	return activity
+}
+
+func (f *Fixtures) account(address helium.Address) (helium.AccountData, bool) {
+	for _, account := range f.Accounts {
+		if account.Address == address {
+			return account, true
+		}
+	}
+	return helium.AccountData{}, false
+}
+
+func (f *Fixtures) block(id string) (helium.BlockData, bool) {
+	height, err := strconv.Atoi(id)
+	for _, block := range f.Blocks {
+		if (err == nil && block.Height == height) || block.Hash == id {
+			return block, true
+		}
+	}
+	return helium.BlockData{}, false
+}
+
+func (f *Fixtures) hotspotsWhere(match func(helium.HotspotData) bool) []helium.HotspotData {
+	hotspots := []helium.HotspotData{}
+	for _, hotspot := range f.Hotspots {
+		if match(hotspot) {
+			hotspots = append(hotspots, hotspot)
+		}
+	}
+	return hotspots
+}
+
+// haversine returns the distance in meters between two coordinates
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadius = 6371000
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+}
+
+func writePage(w http.ResponseWriter, data interface{}, cursor string) {
+	body := map[string]interface{}{"data": data}
+	if len(cursor) > 0 {
+		body["cursor"] = cursor
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// writeSum writes sum, or an empty list of buckets when the request asks for the sum split into buckets
+func writeSum(w http.ResponseWriter, r *http.Request, sum interface{}) {
+	bucket := r.URL.Query().Get("bucket")
+	if len(bucket) == 0 {
+		writeData(w, sum)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": []struct{}{},
+		"meta": map[string]string{"bucket": bucket},
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
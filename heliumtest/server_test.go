@@ -0,0 +1,270 @@
+package heliumtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	helium "github.com/dougkirkley/helium-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerPaging(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.PageSize = 40
+
+	accounts := server.Client().Account().ListAll()
+	count := 0
+	for accounts.Next(context.Background()) {
+		count++
+	}
+	assert.NoError(t, accounts.Err())
+	assert.Equal(t, len(server.Fixtures.Accounts), count)
+	assert.Equal(t, 4, accounts.Pages())
+}
+
+func TestServerFail(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Fail("/hotspots", http.StatusNotFound, 1)
+	client := server.Client()
+	_, err := client.Hotspot().Get(&helium.HotspotInput{Address: server.Fixtures.Hotspots[0].Address})
+	assert.True(t, errors.Is(err, helium.ErrNotFound))
+
+	hotspot, err := client.Hotspot().Get(&helium.HotspotInput{Address: server.Fixtures.Hotspots[0].Address})
+	assert.NoError(t, err)
+	assert.Equal(t, server.Fixtures.Hotspots[0].Name, hotspot.Data.Name)
+
+	server.FailRetryAfter("/blocks", http.StatusTooManyRequests, 2, "0")
+	client = server.Client(helium.WithRetryPolicy(helium.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}))
+	before := server.Requests()
+	height, err := client.Block().CurrentHeight(&helium.BlockCursorInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, server.Fixtures.Blocks[0].Height, height.Data.Height)
+	assert.Equal(t, 3, server.Requests()-before)
+
+	server.Fail("/stats", http.StatusServiceUnavailable, 0)
+	_, err = client.Stat().List()
+	assert.True(t, errors.Is(err, helium.ErrServer))
+	server.ClearFailures()
+	_, err = client.Stat().List()
+	assert.NoError(t, err)
+}
+
+func TestServerUnknown(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	_, err := server.Client().Account().Get(&helium.AccountInput{ID: Address("nobody")})
+	assert.True(t, errors.Is(err, helium.ErrNotFound))
+}
+
+func TestServerSubmit(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	err := server.Client().PendingTransaction().Submit(&helium.TransactionSubmitInput{Transaction: "txn"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dHhu"}, server.Submitted())
+}
+
+func TestServerLocation(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	hotspot := server.Fixtures.Hotspots[0]
+	hotspots, err := server.Client().Hotspot().Distance(&helium.HotspotDistanceInput{Lat: hotspot.Lat, Lon: hotspot.Lng, Distance: 10})
+	assert.NoError(t, err)
+	if assert.Len(t, hotspots.Data, 1) {
+		assert.Equal(t, hotspot.Address, hotspots.Data[0].Address)
+	}
+
+	cities, err := server.Client().City().Search(&helium.CitySearchInput{Term: "austin"})
+	assert.NoError(t, err)
+	if assert.Len(t, cities.Data, 1) {
+		assert.Equal(t, 8, cities.Data[0].HotspotCount)
+	}
+}
+
+func TestServerVars(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	vars, err := server.Client().Vars().List()
+	assert.NoError(t, err)
+	assert.Equal(t, server.Fixtures.Vars, vars.Data)
+
+	v, err := server.Client().Vars().Get("txn_fee_multiplier")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5000), v.Data.Int)
+
+	keys, err := vars.Data.Binary("price_oracle_public_keys")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 3*34)
+	assert.Equal(t, byte(33), keys[0])
+	assert.Equal(t, Address("oracle-0").Bytes(), keys[1:34])
+	assert.Equal(t, Address("oracle-2").Bytes(), keys[69:])
+}
+
+func TestServerOuis(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	last, err := server.Client().Oui().Last()
+	assert.NoError(t, err)
+	oui, err := server.Client().Oui().Get(last.Data.Oui)
+	assert.NoError(t, err)
+	assert.Equal(t, last.Data, oui.Data)
+	stats, err := server.Client().Oui().Stats()
+	assert.NoError(t, err)
+	assert.Equal(t, len(server.Fixtures.Ouis), stats.Data.Count)
+
+	_, err = server.Client().Oui().Get(99)
+	assert.True(t, errors.Is(err, helium.ErrNotFound))
+}
+
+func TestServerHotspotTraffic(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.PageSize = 2
+
+	start := server.Fixtures.StateChannels[0].TxnTime()
+	hotspot := server.Fixtures.Hotspots[1].Address
+	traffic, err := server.Client().StateChannel().HotspotTraffic(&helium.HotspotTrafficInput{Address: hotspot, MinTime: helium.At(start.Add(-25 * time.Minute))})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, traffic.StateChannels)
+	assert.Equal(t, 60, traffic.NumPackets)
+	assert.Equal(t, helium.DC(60), traffic.NumDcs)
+	// the pager stops at the first page past the window
+	assert.Equal(t, 2, server.Requests())
+
+	traffic, err = server.Client().StateChannel().HotspotTraffic(&helium.HotspotTrafficInput{Address: hotspot, MinTime: helium.At(start.Add(-25 * time.Minute)), MaxTime: helium.At(start)})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, traffic.StateChannels)
+
+	traffic, err = server.Client().StateChannel().HotspotTraffic(&helium.HotspotTrafficInput{Address: server.Fixtures.Hotspots[5].Address, MinTime: helium.At(start.Add(-time.Hour))})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, traffic.StateChannels)
+
+	stats, err := server.Client().StateChannel().Stats()
+	assert.NoError(t, err)
+	assert.Equal(t, 5*60, stats.Data.LastMonth.NumPackets)
+}
+
+func TestServerDCBurns(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	burns, err := server.Client().DCBurn().List(&helium.DCBurnListInput{})
+	assert.NoError(t, err)
+	assert.Len(t, burns.Data, len(server.Fixtures.StateChannels))
+
+	value, err := server.Client().DCBurn().Value(burns.Data)
+	assert.NoError(t, err)
+	// 60 DC per state channel, the newest burned at $15.00 and the rest at $15.10
+	assert.Equal(t, helium.DC(300), value.DC)
+	assert.Equal(t, 0.003, value.USD)
+	assert.Equal(t, helium.DC(60).HNT(1500000000)+4*helium.DC(60).HNT(1510000000), value.HNT)
+
+	sum, err := server.Client().DCBurn().Sum(&helium.DCBurnSumInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, helium.DC(300), sum.Data.StateChannel)
+	assert.Equal(t, helium.DC(300), sum.Data.Total())
+}
+
+func TestServerChallengesElections(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.PageSize = 4
+
+	var witnesses []helium.Address
+	pager := server.Client().Challenge().ListAll(&helium.ChallengeListInput{})
+	for pager.Next(context.Background()) {
+		witnesses = append(witnesses, pager.Item().Path[0].Witnesses[0].Gateway)
+	}
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, 2, pager.Pages())
+	assert.Len(t, witnesses, len(server.Fixtures.Challenges))
+	assert.Equal(t, server.Fixtures.Hotspots[2].Address, witnesses[0])
+
+	stats, err := server.Client().Challenge().Stats()
+	assert.NoError(t, err)
+	assert.Equal(t, server.Fixtures.Stats.ChallengeCounts, stats.Data)
+
+	elections, err := server.Client().Election().List(&helium.ElectionListInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, server.Fixtures.Elections, elections.Data)
+}
+
+func TestServerWitnesses(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	// hotspot 2 is challenged by hotspot 1 and witnessed by hotspot 3, and witnesses hotspot 1 being challenged
+	hotspot := server.Fixtures.Hotspots[2].Address
+	witnesses, err := server.Client().Hotspot().Witnesses(&helium.HotspotInput{Address: hotspot})
+	assert.NoError(t, err)
+	assert.Equal(t, map[helium.Address]int{server.Fixtures.Hotspots[3].Address: 1}, witnesses.Counts())
+	rssi, ok := witnesses.Data[0].WitnessInfo.MedianRSSI()
+	assert.True(t, ok)
+	assert.Equal(t, -110, rssi)
+
+	witnessed, err := server.Client().Hotspot().Witnessed(&helium.HotspotInput{Address: hotspot})
+	assert.NoError(t, err)
+	assert.Equal(t, map[helium.Address]int{server.Fixtures.Hotspots[1].Address: 1}, witnessed.Counts())
+}
+
+func TestServerRoles(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.PageSize = 1
+
+	input := &helium.AccountActivityInput{ID: ActiveAccount, FilterTypes: []helium.TxnType{helium.TxnRewardsV2}}
+	var heights []int
+	pager := server.Client().Account().RolesAll(input)
+	for pager.Next(context.Background()) {
+		assert.Equal(t, "payee", pager.Item().Role)
+		heights = append(heights, pager.Item().Height)
+	}
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []int{server.Fixtures.Activity[ActiveAccount][0].Height, server.Fixtures.Activity[ActiveAccount][1].Height}, heights)
+
+	input.FilterTypes = []helium.TxnType{helium.TxnPaymentV2}
+	activity, err := server.Client().Account().Activity(input)
+	assert.NoError(t, err)
+	assert.Empty(t, activity.Data)
+}
+
+func TestServerRewards(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.PageSize = 3
+
+	var hashes []string
+	pager := server.Client().Reward().ListAll(&helium.RewardListInput{})
+	for pager.Next(context.Background()) {
+		hashes = append(hashes, pager.Item().TxnHash())
+	}
+	assert.NoError(t, pager.Err())
+	assert.Len(t, hashes, len(server.Fixtures.Rewards))
+	assert.Equal(t, server.Fixtures.Rewards[3].Hash, hashes[3])
+
+	// every transaction pays 1.25 HNT and 0.25 HNT times its index plus one
+	sum, err := server.Client().Reward().Sum(&helium.RewardSumInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, helium.HNT(750000000), sum.Data.Sum)
+
+	buckets, err := server.Client().Reward().SumBuckets(&helium.RewardSumInput{}, helium.BucketDay)
+	assert.NoError(t, err)
+	assert.Equal(t, helium.BucketDay, buckets.Meta.Bucket)
+	if assert.Len(t, buckets.Data, 2) {
+		assert.Equal(t, time.Date(2021, 5, 31, 0, 0, 0, 0, time.UTC), buckets.Data[0].Timestamp.UTC())
+		assert.Equal(t, helium.HNT(325000000), buckets.Data[0].Sum)
+		assert.Equal(t, helium.HNT(425000000), buckets.Data[1].Sum)
+		assert.Equal(t, 4.25, buckets.Data[1].Total)
+	}
+}
@@ -2,9 +2,11 @@ package helium
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 )
 
 // Hotspot handles api endpoint /hotspots docs located at https://docs.helium.com/api/blockchain/hotspots
@@ -28,14 +30,14 @@ type HotspotsData struct {
 	Status            Status  `json:"status"`
 	ScoreUpdateHeight int     `json:"score_update_height"`
 	Score             float64 `json:"score"`
-	Owner             string  `json:"owner"`
+	Owner             Address `json:"owner"`
 	Nonce             int     `json:"nonce"`
 	Name              string  `json:"name"`
 	Location          string  `json:"location"`
 	Geocode           Geocode `json:"geocode"`
 	BlockAdded        int     `json:"block_added"`
 	Block             int     `json:"block"`
-	Address           string  `json:"address"`
+	Address           Address `json:"address"`
 }
 
 type HotspotInfo struct {
@@ -43,7 +45,7 @@ type HotspotInfo struct {
 }
 
 type HotspotData struct {
-	Address           string  `json:"address"`
+	Address           Address `json:"address"`
 	Block             int     `json:"block"`
 	BlockAdded        int     `json:"block_added"`
 	Geocode           Geocode `json:"geocode"`
@@ -52,30 +54,31 @@ type HotspotData struct {
 	Location          string  `json:"location"`
 	Name              string  `json:"name"`
 	Nonce             int     `json:"nonce"`
-	Owner             string  `json:"owner"`
+	Owner             Address `json:"owner"`
 	Score             float64 `json:"score"`
 	ScoreUpdateHeight int     `json:"score_update_height"`
 	Status            Status  `json:"status"`
 }
 
 type HotspotsActivity struct {
-	Data []HotspotsActivityData `json:"data"`
+	Data   []HotspotsActivityData `json:"data"`
+	Cursor string                 `json:"cursor"`
 }
 
 type HotspotsActivityData struct {
-	Fee        int     `json:"fee"`
-	Gateway    string  `json:"gateway"`
-	Hash       string  `json:"hash"`
-	Height     int     `json:"height"`
-	Lat        float64 `json:"lat,omitempty"`
-	Lng        float64 `json:"lng,omitempty"`
-	Location   string  `json:"location,omitempty"`
-	Nonce      int     `json:"nonce,omitempty"`
-	Owner      string  `json:"owner"`
-	Payer      string  `json:"payer"`
-	StakingFee int     `json:"staking_fee"`
-	Time       int     `json:"time"`
-	Type       string  `json:"type"`
+	Fee        DC        `json:"fee"`
+	Gateway    Address   `json:"gateway"`
+	Hash       string    `json:"hash"`
+	Height     int       `json:"height"`
+	Lat        float64   `json:"lat,omitempty"`
+	Lng        float64   `json:"lng,omitempty"`
+	Location   string    `json:"location,omitempty"`
+	Nonce      int       `json:"nonce,omitempty"`
+	Owner      Address   `json:"owner"`
+	Payer      Address   `json:"payer"`
+	StakingFee DC        `json:"staking_fee"`
+	Time       Timestamp `json:"time"`
+	Type       string    `json:"type"`
 }
 
 type HotspotActivityCount struct {
@@ -91,7 +94,7 @@ type Witnesses struct {
 }
 
 type WitnessData struct {
-	Address           string      `json:"address"`
+	Address           Address     `json:"address"`
 	Block             int         `json:"block"`
 	BlockAdded        int         `json:"block_added"`
 	Geocode           Geocode     `json:"geocode"`
@@ -100,26 +103,92 @@ type WitnessData struct {
 	Location          string      `json:"location"`
 	Name              string      `json:"name"`
 	Nonce             int         `json:"nonce"`
-	Owner             string      `json:"owner"`
+	Owner             Address     `json:"owner"`
 	Score             float64     `json:"score"`
 	ScoreUpdateHeight int         `json:"score_update_height"`
 	Status            Status      `json:"status"`
-	WitnessFor        string      `json:"witness_for"`
+	WitnessFor        Address     `json:"witness_for"`
 	WitnessInfo       WitnessInfo `json:"witness_info"`
 }
 
-type Histogram struct {
+// Histogram counts witness reports in buckets keyed by the upper bound of each bucket, in dBm for RSSI and dB for SNR
+type Histogram map[int]int
+
+// Count returns the number of reports in the histogram
+func (h Histogram) Count() int {
+	var n int
+	for _, count := range h {
+		n += count
+	}
+	return n
+}
+
+// Median returns the bucket holding the median report, false when the histogram is empty
+func (h Histogram) Median() (int, bool) {
+	total := h.Count()
+	if total == 0 {
+		return 0, false
+	}
+	buckets := make([]int, 0, len(h))
+	for bucket := range h {
+		buckets = append(buckets, bucket)
+	}
+	sort.Ints(buckets)
+	var seen int
+	for _, bucket := range buckets {
+		seen += h[bucket]
+		if 2*seen >= total {
+			return bucket, true
+		}
+	}
+	return buckets[len(buckets)-1], true
 }
 
 type WitnessInfo struct {
-	FirstTime  int64     `json:"first_time"`
-	Histogram  Histogram `json:"histogram"`
-	RecentTime int64     `json:"recent_time"`
+	FirstTime    Timestamp `json:"first_time"`
+	Histogram    Histogram `json:"histogram"`
+	SnrHistogram Histogram `json:"snr_histogram,omitempty"`
+	RecentTime   Timestamp `json:"recent_time"`
+}
+
+// Count returns the number of times the pair of hotspots witnessed each other's beacons
+func (w WitnessInfo) Count() int {
+	return w.Histogram.Count()
+}
+
+// MedianRSSI returns the bucket holding the median RSSI of the witness reports, false when there are none
+func (w WitnessInfo) MedianRSSI() (int, bool) {
+	return w.Histogram.Median()
+}
+
+// Counts returns the number of witness reports for each neighbouring hotspot
+func (w *Witnesses) Counts() map[Address]int {
+	counts := make(map[Address]int, len(w.Data))
+	for _, witness := range w.Data {
+		counts[witness.Address] += witness.WitnessInfo.Count()
+	}
+	return counts
+}
+
+type HotspotListInput struct {
+	Cursor string
 }
 
 type HotspotInput struct {
-	Address string
+	Address Address
 	Name    string
+	Cursor  string
+}
+
+// HotspotActivityInput narrows the activity or roles of a hotspot to transaction types and a time window,
+// Limit caps the number of transactions per page
+type HotspotActivityInput struct {
+	Address     Address
+	FilterTypes []TxnType
+	MinTime     TimeBound
+	MaxTime     TimeBound
+	Limit       int
+	Cursor      string
 }
 
 type HotspotSearchInput struct {
@@ -130,13 +199,15 @@ type HotspotDistanceInput struct {
 	Lat      float64
 	Lon      float64
 	Distance int
+	Cursor   string
 }
 
 type HotspotBoxInput struct {
-	Swlat float64
-	Swlon float64
-	Nelat float64
-	Nelon float64
+	Swlat  float64
+	Swlon  float64
+	Nelat  float64
+	Nelon  float64
+	Cursor string
 }
 
 type HotspotHexInput struct {
@@ -144,14 +215,24 @@ type HotspotHexInput struct {
 }
 
 type HotspotRewardsInput struct {
-	Address string
-	MaxTime string
-	MinTime string
+	Address Address
+	MaxTime TimeBound
+	MinTime TimeBound
+	Cursor  string
 }
 
 // List known hotspots as registered on the blockchain.
-func (h *Hotspot) List() (*Hotspots, error) {
-	resp, err := h.c.Request(http.MethodGet, "/hotspots", new(bytes.Buffer), nil)
+func (h *Hotspot) List(input *HotspotListInput) (*Hotspots, error) {
+	return h.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (h *Hotspot) ListWithContext(ctx context.Context, input *HotspotListInput) (*Hotspots, error) {
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, "/hotspots", new(bytes.Buffer), params)
 	if err != nil {
 		return &Hotspots{}, err
 	}
@@ -165,9 +246,25 @@ func (h *Hotspot) List() (*Hotspots, error) {
 	return hotspots, nil
 }
 
+// ListAll iterates over every hotspot registered on the blockchain.
+func (h *Hotspot) ListAll(opts ...PagerOption) *HotspotPager {
+	return NewHotspotPager(func(ctx context.Context, cursor string) (*Hotspots, error) {
+		return h.ListWithContext(ctx, &HotspotListInput{Cursor: cursor})
+	}, opts...)
+}
+
 // Get Fetch a hotspot with a given address.
 func (h *Hotspot) Get(input *HotspotInput) (*HotspotInfo, error) {
-	resp, err := h.c.Request(http.MethodGet, fmt.Sprintf("/hotspots/%s", input.Address), new(bytes.Buffer), nil)
+	return h.GetWithContext(context.Background(), input)
+}
+
+// GetWithContext is Get with a caller supplied context.
+func (h *Hotspot) GetWithContext(ctx context.Context, input *HotspotInput) (*HotspotInfo, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &HotspotInfo{}, err
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s", input.Address), new(bytes.Buffer), nil)
 	if err != nil {
 		return &HotspotInfo{}, err
 	}
@@ -183,7 +280,12 @@ func (h *Hotspot) Get(input *HotspotInput) (*HotspotInfo, error) {
 
 // GetByName Fetch the hotspots which map to the given 3-word animal name.
 func (h *Hotspot) GetByName(input *HotspotInput) (*Hotspots, error) {
-	resp, err := h.c.Request(http.MethodGet, fmt.Sprintf("/hotspots/name/%s", input.Name), new(bytes.Buffer), nil)
+	return h.GetByNameWithContext(context.Background(), input)
+}
+
+// GetByNameWithContext is GetByName with a caller supplied context.
+func (h *Hotspot) GetByNameWithContext(ctx context.Context, input *HotspotInput) (*Hotspots, error) {
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/name/%s", input.Name), new(bytes.Buffer), nil)
 	if err != nil {
 		return &Hotspots{}, err
 	}
@@ -199,12 +301,17 @@ func (h *Hotspot) GetByName(input *HotspotInput) (*Hotspots, error) {
 
 // Search Fetch the hotspots which match a search term in the given search term query parameter.
 func (h *Hotspot) Search(input *HotspotSearchInput) (*Hotspots, error) {
+	return h.SearchWithContext(context.Background(), input)
+}
+
+// SearchWithContext is Search with a caller supplied context.
+func (h *Hotspot) SearchWithContext(ctx context.Context, input *HotspotSearchInput) (*Hotspots, error) {
 	if len(input.Term) < 1 {
 		return &Hotspots{}, fmt.Errorf("search term must be 1 character or more, 3 is recommended")
 	}
 	params := make(map[string]string)
 	params["search"] = input.Term
-	resp, err := h.c.Request(http.MethodGet, "/hotspots/name", new(bytes.Buffer), params)
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, "/hotspots/name", new(bytes.Buffer), params)
 	if err != nil {
 		return &Hotspots{}, err
 	}
@@ -220,11 +327,19 @@ func (h *Hotspot) Search(input *HotspotSearchInput) (*Hotspots, error) {
 
 // Distance Fetch the hotspots which are within a given number of meters from the given lat and lon coordinates.
 func (h *Hotspot) Distance(input *HotspotDistanceInput) (*Hotspots, error) {
+	return h.DistanceWithContext(context.Background(), input)
+}
+
+// DistanceWithContext is Distance with a caller supplied context.
+func (h *Hotspot) DistanceWithContext(ctx context.Context, input *HotspotDistanceInput) (*Hotspots, error) {
 	params := make(map[string]string)
 	params["lat"] = fmt.Sprintf("%v", input.Lat)
 	params["lon"] = fmt.Sprintf("%v", input.Lon)
 	params["distance"] = fmt.Sprintf("%v", input.Distance)
-	resp, err := h.c.Request(http.MethodGet, "/hotspots/location/distance", new(bytes.Buffer), params)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, "/hotspots/location/distance", new(bytes.Buffer), params)
 	if err != nil {
 		return &Hotspots{}, err
 	}
@@ -238,14 +353,31 @@ func (h *Hotspot) Distance(input *HotspotDistanceInput) (*Hotspots, error) {
 	return hotspots, nil
 }
 
+// DistanceAll iterates over every hotspot within a given number of meters from the given lat and lon coordinates.
+func (h *Hotspot) DistanceAll(input *HotspotDistanceInput, opts ...PagerOption) *HotspotPager {
+	return NewHotspotPager(func(ctx context.Context, cursor string) (*Hotspots, error) {
+		page := *input
+		page.Cursor = cursor
+		return h.DistanceWithContext(ctx, &page)
+	}, opts...)
+}
+
 // Box Fetch the hotspots which are within a given geographic boundary indicated by it's south-wesetern and north-eastern co-ordinates.
 func (h *Hotspot) Box(input *HotspotBoxInput) (*Hotspots, error) {
+	return h.BoxWithContext(context.Background(), input)
+}
+
+// BoxWithContext is Box with a caller supplied context.
+func (h *Hotspot) BoxWithContext(ctx context.Context, input *HotspotBoxInput) (*Hotspots, error) {
 	params := make(map[string]string)
 	params["swlat"] = fmt.Sprintf("%v", input.Swlat)
 	params["swlon"] = fmt.Sprintf("%v", input.Swlon)
 	params["nelat"] = fmt.Sprintf("%v", input.Nelat)
 	params["nelon"] = fmt.Sprintf("%v", input.Nelon)
-	resp, err := h.c.Request(http.MethodGet, "/hotspots/location/box", new(bytes.Buffer), params)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, "/hotspots/location/box", new(bytes.Buffer), params)
 	if err != nil {
 		return &Hotspots{}, err
 	}
@@ -259,9 +391,23 @@ func (h *Hotspot) Box(input *HotspotBoxInput) (*Hotspots, error) {
 	return hotspots, nil
 }
 
+// BoxAll iterates over every hotspot within a given geographic boundary.
+func (h *Hotspot) BoxAll(input *HotspotBoxInput, opts ...PagerOption) *HotspotPager {
+	return NewHotspotPager(func(ctx context.Context, cursor string) (*Hotspots, error) {
+		page := *input
+		page.Cursor = cursor
+		return h.BoxWithContext(ctx, &page)
+	}, opts...)
+}
+
 // GetByHex Fetch the hotspots which are in the given h3 index.
 func (h *Hotspot) GetByHex(input *HotspotHexInput) (*HotspotInfo, error) {
-	resp, err := h.c.Request(http.MethodGet, fmt.Sprintf("/hotspots/hex/%s", input.ID), new(bytes.Buffer), nil)
+	return h.GetByHexWithContext(context.Background(), input)
+}
+
+// GetByHexWithContext is GetByHex with a caller supplied context.
+func (h *Hotspot) GetByHexWithContext(ctx context.Context, input *HotspotHexInput) (*HotspotInfo, error) {
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/hex/%s", input.ID), new(bytes.Buffer), nil)
 	if err != nil {
 		return &HotspotInfo{}, err
 	}
@@ -275,8 +421,22 @@ func (h *Hotspot) GetByHex(input *HotspotHexInput) (*HotspotInfo, error) {
 }
 
 // Activity Lists all blockchain transactions that the given hotspot was involved in.
-func (h *Hotspot) Activity(input *HotspotInput) (*HotspotsActivity, error) {
-	resp, err := h.c.Request(http.MethodGet, fmt.Sprintf("/hotspots/%s/activity", input.Address), new(bytes.Buffer), nil)
+func (h *Hotspot) Activity(input *HotspotActivityInput) (*HotspotsActivity, error) {
+	return h.ActivityWithContext(context.Background(), input)
+}
+
+// ActivityWithContext is Activity with a caller supplied context.
+func (h *Hotspot) ActivityWithContext(ctx context.Context, input *HotspotActivityInput) (*HotspotsActivity, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &HotspotsActivity{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	activityParams(params, input.FilterTypes, input.MinTime, input.MaxTime, input.Limit)
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/activity", input.Address), new(bytes.Buffer), params)
 	if err != nil {
 		return &HotspotsActivity{}, err
 	}
@@ -290,9 +450,66 @@ func (h *Hotspot) Activity(input *HotspotInput) (*HotspotsActivity, error) {
 	return hotspotsActivity, nil
 }
 
+// ActivityAll iterates over every blockchain transaction that the given hotspot was involved in.
+func (h *Hotspot) ActivityAll(input *HotspotActivityInput, opts ...PagerOption) *HotspotActivityPager {
+	return NewHotspotActivityPager(func(ctx context.Context, cursor string) (*HotspotsActivity, error) {
+		page := *input
+		page.Cursor = cursor
+		return h.ActivityWithContext(ctx, &page)
+	}, opts...)
+}
+
+// Roles Lists the blockchain transactions the given hotspot took part in and its role in each.
+func (h *Hotspot) Roles(input *HotspotActivityInput) (*Roles, error) {
+	return h.RolesWithContext(context.Background(), input)
+}
+
+// RolesWithContext is Roles with a caller supplied context.
+func (h *Hotspot) RolesWithContext(ctx context.Context, input *HotspotActivityInput) (*Roles, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &Roles{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	activityParams(params, input.FilterTypes, input.MinTime, input.MaxTime, input.Limit)
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/roles", input.Address), new(bytes.Buffer), params)
+	if err != nil {
+		return &Roles{}, err
+	}
+	defer resp.Body.Close()
+
+	var roles *Roles
+	err = json.NewDecoder(resp.Body).Decode(&roles)
+	if err != nil {
+		return &Roles{}, err
+	}
+	return roles, nil
+}
+
+// RolesAll iterates over every blockchain transaction the given hotspot took part in.
+func (h *Hotspot) RolesAll(input *HotspotActivityInput, opts ...PagerOption) *RolePager {
+	return NewRolePager(func(ctx context.Context, cursor string) (*Roles, error) {
+		page := *input
+		page.Cursor = cursor
+		return h.RolesWithContext(ctx, &page)
+	}, opts...)
+}
+
 // ActivityCount Count transactions that indicate activity for a hotspot.
 func (h *Hotspot) ActivityCount(input *HotspotInput) (*HotspotActivityCount, error) {
-	resp, err := h.c.Request(http.MethodGet, fmt.Sprintf("/hotspots/%s/activity/count", input.Address), new(bytes.Buffer), nil)
+	return h.ActivityCountWithContext(context.Background(), input)
+}
+
+// ActivityCountWithContext is ActivityCount with a caller supplied context.
+func (h *Hotspot) ActivityCountWithContext(ctx context.Context, input *HotspotInput) (*HotspotActivityCount, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &HotspotActivityCount{}, err
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/activity/count", input.Address), new(bytes.Buffer), nil)
 	if err != nil {
 		return &HotspotActivityCount{}, err
 	}
@@ -308,7 +525,20 @@ func (h *Hotspot) ActivityCount(input *HotspotInput) (*HotspotActivityCount, err
 
 // Elections Lists the consensus group transactions that the given hotspot was involved in.
 func (h *Hotspot) Elections(input *HotspotInput) (*Elections, error) {
-	resp, err := h.c.Request(http.MethodGet, fmt.Sprintf("/hotspots/%s/elections", input.Address), new(bytes.Buffer), nil)
+	return h.ElectionsWithContext(context.Background(), input)
+}
+
+// ElectionsWithContext is Elections with a caller supplied context.
+func (h *Hotspot) ElectionsWithContext(ctx context.Context, input *HotspotInput) (*Elections, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &Elections{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/elections", input.Address), new(bytes.Buffer), params)
 	if err != nil {
 		return &Elections{}, err
 	}
@@ -322,9 +552,23 @@ func (h *Hotspot) Elections(input *HotspotInput) (*Elections, error) {
 	return elections, nil
 }
 
+// ElectionsAll iterates over every consensus group transaction that the given hotspot was involved in.
+func (h *Hotspot) ElectionsAll(input *HotspotInput, opts ...PagerOption) *ElectionPager {
+	return NewElectionPager(func(ctx context.Context, cursor string) (*Elections, error) {
+		page := *input
+		page.Cursor = cursor
+		return h.ElectionsWithContext(ctx, &page)
+	}, opts...)
+}
+
 // CurrentlyElected Returns the list of hotspots that are currently elected to the consensus group.
 func (h *Hotspot) CurrentlyElected() (*Elections, error) {
-	resp, err := h.c.Request(http.MethodGet, "/hotspots/elected", new(bytes.Buffer), nil)
+	return h.CurrentlyElectedWithContext(context.Background())
+}
+
+// CurrentlyElectedWithContext is CurrentlyElected with a caller supplied context.
+func (h *Hotspot) CurrentlyElectedWithContext(ctx context.Context) (*Elections, error) {
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, "/hotspots/elected", new(bytes.Buffer), nil)
 	if err != nil {
 		return &Elections{}, err
 	}
@@ -340,7 +584,20 @@ func (h *Hotspot) CurrentlyElected() (*Elections, error) {
 
 // Challenges Lists the challenge (receipts) that the given hotspot a challenger, challengee or a witness in.
 func (h *Hotspot) Challenges(input *HotspotInput) (*Challenges, error) {
-	resp, err := h.c.Request(http.MethodGet, fmt.Sprintf("/hotspots/%s/challenges", input.Address), new(bytes.Buffer), nil)
+	return h.ChallengesWithContext(context.Background(), input)
+}
+
+// ChallengesWithContext is Challenges with a caller supplied context.
+func (h *Hotspot) ChallengesWithContext(ctx context.Context, input *HotspotInput) (*Challenges, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &Challenges{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/challenges", input.Address), new(bytes.Buffer), params)
 	if err != nil {
 		return &Challenges{}, err
 	}
@@ -354,12 +611,32 @@ func (h *Hotspot) Challenges(input *HotspotInput) (*Challenges, error) {
 	return challenges, nil
 }
 
+// ChallengesAll iterates over every challenge that the given hotspot was involved in.
+func (h *Hotspot) ChallengesAll(input *HotspotInput, opts ...PagerOption) *ChallengePager {
+	return NewChallengePager(func(ctx context.Context, cursor string) (*Challenges, error) {
+		page := *input
+		page.Cursor = cursor
+		return h.ChallengesWithContext(ctx, &page)
+	}, opts...)
+}
+
 // Rewards Returns reward entries by block and gateway for a given account in a timeframe.
 func (h *Hotspot) Rewards(input *HotspotRewardsInput) (*Rewards, error) {
+	return h.RewardsWithContext(context.Background(), input)
+}
+
+// RewardsWithContext is Rewards with a caller supplied context.
+func (h *Hotspot) RewardsWithContext(ctx context.Context, input *HotspotRewardsInput) (*Rewards, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &Rewards{}, err
+	}
 	params := make(map[string]string)
-	params["min_time"] = input.MinTime
-	params["max_time"] = input.MaxTime
-	resp, err := h.c.Request(http.MethodGet, fmt.Sprintf("/hotspots/%s/rewards", input.Address), new(bytes.Buffer), params)
+	timeParams(params, input.MinTime, input.MaxTime)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/rewards", input.Address), new(bytes.Buffer), params)
 	if err != nil {
 		return &Rewards{}, err
 	}
@@ -373,9 +650,29 @@ func (h *Hotspot) Rewards(input *HotspotRewardsInput) (*Rewards, error) {
 	return rewards, nil
 }
 
-//RewardSum Returns rewards for a given hotspot per reward block the hotspot is in, for a given timeframe.
-func (h *Hotspot) RewardSum(input *HotspotInput) (*RewardSum, error) {
-	resp, err := h.c.Request(http.MethodGet, fmt.Sprintf("/hotspots/%s/rewards/sum", input.Address), new(bytes.Buffer), nil)
+// RewardsAll iterates over every reward entry for a given hotspot in a timeframe.
+func (h *Hotspot) RewardsAll(input *HotspotRewardsInput, opts ...PagerOption) *RewardPager {
+	return NewRewardPager(func(ctx context.Context, cursor string) (*Rewards, error) {
+		page := *input
+		page.Cursor = cursor
+		return h.RewardsWithContext(ctx, &page)
+	}, opts...)
+}
+
+// RewardSum Returns rewards for a given hotspot per reward block the hotspot is in, for a given timeframe.
+func (h *Hotspot) RewardSum(input *HotspotRewardsInput) (*RewardSum, error) {
+	return h.RewardSumWithContext(context.Background(), input)
+}
+
+// RewardSumWithContext is RewardSum with a caller supplied context.
+func (h *Hotspot) RewardSumWithContext(ctx context.Context, input *HotspotRewardsInput) (*RewardSum, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &RewardSum{}, err
+	}
+	params := make(map[string]string)
+	timeParams(params, input.MinTime, input.MaxTime)
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/rewards/sum", input.Address), new(bytes.Buffer), params)
 	if err != nil {
 		return &RewardSum{}, err
 	}
@@ -388,3 +685,83 @@ func (h *Hotspot) RewardSum(input *HotspotInput) (*RewardSum, error) {
 	}
 	return rewardSum, nil
 }
+
+// RewardSumBuckets Returns the rewards for a given hotspot in a given timeframe, summed per bucket.
+func (h *Hotspot) RewardSumBuckets(input *HotspotRewardsInput, bucket Bucket) (*RewardBuckets, error) {
+	return h.RewardSumBucketsWithContext(context.Background(), input, bucket)
+}
+
+// RewardSumBucketsWithContext is RewardSumBuckets with a caller supplied context.
+func (h *Hotspot) RewardSumBucketsWithContext(ctx context.Context, input *HotspotRewardsInput, bucket Bucket) (*RewardBuckets, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	params := make(map[string]string)
+	err = bucketParams(params, input.MinTime, input.MaxTime, bucket)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/rewards/sum", input.Address), new(bytes.Buffer), params)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	defer resp.Body.Close()
+
+	var rewardBuckets *RewardBuckets
+	err = json.NewDecoder(resp.Body).Decode(&rewardBuckets)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	return rewardBuckets, nil
+}
+
+// Witnesses Returns the hotspots that witnessed the given hotspot's beacons, with a histogram of their reports.
+func (h *Hotspot) Witnesses(input *HotspotInput) (*Witnesses, error) {
+	return h.WitnessesWithContext(context.Background(), input)
+}
+
+// WitnessesWithContext is Witnesses with a caller supplied context.
+func (h *Hotspot) WitnessesWithContext(ctx context.Context, input *HotspotInput) (*Witnesses, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &Witnesses{}, err
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/witnesses", input.Address), new(bytes.Buffer), nil)
+	if err != nil {
+		return &Witnesses{}, err
+	}
+	defer resp.Body.Close()
+
+	var witnesses *Witnesses
+	err = json.NewDecoder(resp.Body).Decode(&witnesses)
+	if err != nil {
+		return &Witnesses{}, err
+	}
+	return witnesses, nil
+}
+
+// Witnessed Returns the hotspots whose beacons the given hotspot witnessed, with a histogram of its reports.
+func (h *Hotspot) Witnessed(input *HotspotInput) (*Witnesses, error) {
+	return h.WitnessedWithContext(context.Background(), input)
+}
+
+// WitnessedWithContext is Witnessed with a caller supplied context.
+func (h *Hotspot) WitnessedWithContext(ctx context.Context, input *HotspotInput) (*Witnesses, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &Witnesses{}, err
+	}
+	resp, err := h.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/hotspots/%s/witnessed", input.Address), new(bytes.Buffer), nil)
+	if err != nil {
+		return &Witnesses{}, err
+	}
+	defer resp.Body.Close()
+
+	var witnessed *Witnesses
+	err = json.NewDecoder(resp.Body).Decode(&witnessed)
+	if err != nil {
+		return &Witnesses{}, err
+	}
+	return witnessed, nil
+}
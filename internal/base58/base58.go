@@ -0,0 +1,102 @@
+// Package base58 implements the base58 and base58check encodings used for Helium addresses.
+package base58
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	// ErrInvalidCharacter is returned when decoding a string containing a character outside the alphabet
+	ErrInvalidCharacter = errors.New("base58: invalid character")
+	// ErrChecksum is returned when a base58check string fails its checksum
+	ErrChecksum = errors.New("base58: invalid checksum")
+	// ErrTooShort is returned when a base58check string is too short to hold a version and checksum
+	ErrTooShort = errors.New("base58: too short")
+)
+
+var decodeMap [256]int
+
+func init() {
+	for i := range decodeMap {
+		decodeMap[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		decodeMap[alphabet[i]] = i
+	}
+}
+
+var radix = big.NewInt(58)
+
+// Encode encodes b as base58, each leading zero byte becomes a leading 1
+func Encode(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	mod := new(big.Int)
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, alphabet[mod.Int64()])
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+// Decode decodes a base58 string
+func Decode(s string) ([]byte, error) {
+	n := new(big.Int)
+	for i := 0; i < len(s); i++ {
+		v := decodeMap[s[i]]
+		if v < 0 {
+			return nil, ErrInvalidCharacter
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(v)))
+	}
+	zeros := 0
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+// CheckEncode encodes version and payload with a four byte double sha256 checksum
+func CheckEncode(version byte, payload []byte) string {
+	b := make([]byte, 0, 1+len(payload)+4)
+	b = append(b, version)
+	b = append(b, payload...)
+	return Encode(append(b, checksum(b)...))
+}
+
+// CheckDecode decodes a base58check string into its version and payload
+func CheckDecode(s string) (byte, []byte, error) {
+	b, err := Decode(s)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(b) < 5 {
+		return 0, nil, ErrTooShort
+	}
+	data, sum := b[:len(b)-4], b[len(b)-4:]
+	if !bytes.Equal(checksum(data), sum) {
+		return 0, nil, ErrChecksum
+	}
+	return data[0], data[1:], nil
+}
+
+func checksum(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
@@ -0,0 +1,37 @@
+package base58
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode(t *testing.T) {
+	b, _ := hex.DecodeString("00000000287fb4cd")
+	assert.Equal(t, "1111233QC4", Encode(b))
+	assert.Equal(t, "", Encode(nil))
+
+	decoded, err := Decode("1111233QC4")
+	assert.NoError(t, err)
+	assert.Equal(t, b, decoded)
+
+	_, err = Decode("0OIl")
+	assert.Equal(t, ErrInvalidCharacter, err)
+}
+
+func TestCheckDecode(t *testing.T) {
+	address := "13WRNw4fmssJBvMqMnREwe1eCvUVXfnWXSXGcWXyVvAnQUF3D9R"
+	version, payload, err := CheckDecode(address)
+	if assert.NoError(t, err) {
+		assert.Equal(t, byte(0), version)
+		assert.Len(t, payload, 33)
+		assert.Equal(t, byte(1), payload[0])
+		assert.Equal(t, address, CheckEncode(version, payload))
+	}
+
+	_, _, err = CheckDecode("13WRNw4fmssJBvMqMnREwe1eCvUVXfnWXSXGcWXyVvAnQUF3D9S")
+	assert.Equal(t, ErrChecksum, err)
+	_, _, err = CheckDecode("1")
+	assert.Equal(t, ErrTooShort, err)
+}
@@ -0,0 +1,223 @@
+// Command mockgen writes the heliummock package from the service interfaces in api.go.
+// Run it with go generate ./heliummock after changing an interface.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+const (
+	importPath = "github.com/dougkirkley/helium-go"
+	qualifier  = "helium"
+)
+
+type param struct {
+	name     string
+	field    string
+	typ      string
+	variadic bool
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type iface struct {
+	name    string
+	methods []method
+}
+
+func main() {
+	source := flag.String("source", "../api.go", "file declaring the interfaces")
+	out := flag.String("out", "mocks.go", "file to write")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, *source, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var ifaces []iface
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			interfaceType, ok := typeSpec.Type.(*ast.InterfaceType)
+			if !ok || !typeSpec.Name.IsExported() {
+				continue
+			}
+			ifaces = append(ifaces, parseInterface(fset, typeSpec.Name.Name, interfaceType))
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by internal/mockgen from api.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package heliummock\n\nimport (\n\t\"context\"\n\t\"sync\"\n\n\t%s %q\n)\n", qualifier, importPath)
+	for _, i := range ifaces {
+		writeMock(&buf, i)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\n%s", err, buf.String())
+	}
+	err = ioutil.WriteFile(*out, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseInterface(fset *token.FileSet, name string, interfaceType *ast.InterfaceType) iface {
+	i := iface{name: name}
+	for _, field := range interfaceType.Methods.List {
+		funcType := field.Type.(*ast.FuncType)
+		m := method{name: field.Names[0].Name}
+		for n, p := range funcType.Params.List {
+			typ := p.Type
+			variadic := false
+			if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+				typ = ellipsis.Elt
+				variadic = true
+			}
+			names := p.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("v%d", n))}
+			}
+			for _, paramName := range names {
+				m.params = append(m.params, param{
+					name:     paramName.Name,
+					field:    strings.ToUpper(paramName.Name[:1]) + paramName.Name[1:],
+					typ:      qualify(fset, typ),
+					variadic: variadic,
+				})
+			}
+		}
+		if funcType.Results != nil {
+			for _, r := range funcType.Results.List {
+				m.results = append(m.results, qualify(fset, r.Type))
+			}
+		}
+		i.methods = append(i.methods, m)
+	}
+	return i
+}
+
+// qualify prints a type expression, prefixing the package's own exported types with the package name
+func qualify(fset *token.FileSet, expr ast.Expr) string {
+	expr = copyExpr(expr)
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.SelectorExpr:
+			return false
+		case *ast.Ident:
+			if node.IsExported() {
+				node.Name = qualifier + "." + node.Name
+			}
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, expr)
+	return buf.String()
+}
+
+func copyExpr(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return ast.NewIdent(e.Name)
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: copyExpr(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: copyExpr(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: copyExpr(e.Key), Value: copyExpr(e.Value)}
+	}
+	return expr
+}
+
+func (m method) signature() string {
+	var params []string
+	for _, p := range m.params {
+		if p.variadic {
+			params = append(params, p.name+" ..."+p.typ)
+		} else {
+			params = append(params, p.name+" "+p.typ)
+		}
+	}
+	results := strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		results = "(" + results + ")"
+	}
+	return "(" + strings.Join(params, ", ") + ") " + results
+}
+
+func (m method) callStruct() string {
+	var fields []string
+	for _, p := range m.params {
+		typ := p.typ
+		if p.variadic {
+			typ = "[]" + typ
+		}
+		fields = append(fields, fmt.Sprintf("%s %s", p.field, typ))
+	}
+	return "struct {\n" + strings.Join(fields, "\n") + "\n}"
+}
+
+func (m method) args() string {
+	var args []string
+	for _, p := range m.params {
+		if p.variadic {
+			args = append(args, p.name+"...")
+		} else {
+			args = append(args, p.name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+func writeMock(buf *bytes.Buffer, i iface) {
+	mock := i.name + "Mock"
+	fmt.Fprintf(buf, "\n// Ensure, that %s does implement %s.%s.\nvar _ %s.%s = &%s{}\n", mock, qualifier, i.name, qualifier, i.name, mock)
+	fmt.Fprintf(buf, "\n// %s is a mock implementation of %s.%s.\n//\n// Set the Func field of each method the code under test calls, calling a method\n// whose Func is nil panics.\ntype %s struct {\n", mock, qualifier, i.name, mock)
+	for _, m := range i.methods {
+		fmt.Fprintf(buf, "// %sFunc mocks the %s method.\n%sFunc func%s\n\n", m.name, m.name, m.name, m.signature())
+	}
+	fmt.Fprintf(buf, "calls struct {\n")
+	for _, m := range i.methods {
+		fmt.Fprintf(buf, "%s []%s\n", m.name, m.callStruct())
+	}
+	fmt.Fprintf(buf, "}\n")
+	for _, m := range i.methods {
+		fmt.Fprintf(buf, "lock%s sync.RWMutex\n", m.name)
+	}
+	fmt.Fprintf(buf, "}\n")
+
+	for _, m := range i.methods {
+		fmt.Fprintf(buf, "\n// %s calls %sFunc.\nfunc (mock *%s) %s%s {\n", m.name, m.name, mock, m.name, m.signature())
+		fmt.Fprintf(buf, "if mock.%sFunc == nil {\npanic(\"%s.%sFunc: method is nil but %s.%s was just called\")\n}\n", m.name, mock, m.name, i.name, m.name)
+		fmt.Fprintf(buf, "callInfo := %s{\n", m.callStruct())
+		for _, p := range m.params {
+			fmt.Fprintf(buf, "%s: %s,\n", p.field, p.name)
+		}
+		fmt.Fprintf(buf, "}\nmock.lock%s.Lock()\nmock.calls.%s = append(mock.calls.%s, callInfo)\nmock.lock%s.Unlock()\n", m.name, m.name, m.name, m.name)
+		fmt.Fprintf(buf, "return mock.%sFunc(%s)\n}\n", m.name, m.args())
+
+		fmt.Fprintf(buf, "\n// %sCalls gets all the calls that were made to %s.\nfunc (mock *%s) %sCalls() []%s {\n", m.name, m.name, mock, m.name, m.callStruct())
+		fmt.Fprintf(buf, "var calls []%s\nmock.lock%s.RLock()\ncalls = mock.calls.%s\nmock.lock%s.RUnlock()\nreturn calls\n}\n", m.callStruct(), m.name, m.name, m.name)
+	}
+}
@@ -0,0 +1,205 @@
+package helium
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/dougkirkley/helium-go/internal/base58"
+)
+
+// KeyType is the signature scheme of a Helium key
+type KeyType byte
+
+const (
+	// KeyTypeECCCompact is a NIST P-256 key whose public key is just its x coordinate
+	KeyTypeECCCompact KeyType = 0x00
+	// KeyTypeEd25519 is an ed25519 key, used by all wallets created by current Helium tools
+	KeyTypeEd25519 KeyType = 0x01
+)
+
+func (t KeyType) String() string {
+	switch t {
+	case KeyTypeECCCompact:
+		return "ecc_compact"
+	case KeyTypeEd25519:
+		return "ed25519"
+	}
+	return fmt.Sprintf("KeyType(%d)", byte(t))
+}
+
+// Network is the network a key belongs to, it is encoded in the upper bits of the key tag byte
+type Network byte
+
+const (
+	Mainnet Network = 0x00
+	Testnet Network = 0x10
+)
+
+func (n Network) String() string {
+	switch n {
+	case Mainnet:
+		return "mainnet"
+	case Testnet:
+		return "testnet"
+	}
+	return fmt.Sprintf("Network(%d)", byte(n))
+}
+
+var (
+	// ErrKeyType is returned for a key type or tag byte that is not supported
+	ErrKeyType = errors.New("helium: unsupported key type")
+	// ErrInvalidKey is returned when decoding malformed key bytes
+	ErrInvalidKey = errors.New("helium: invalid key")
+)
+
+// Keypair is a Helium private key, it signs transactions for the account at Address
+type Keypair struct {
+	network Network
+	keyType KeyType
+	ed25519 ed25519.PrivateKey
+	ecc     *ecdsa.PrivateKey
+}
+
+// GenerateKeypair creates a new random key of keyType on network
+func GenerateKeypair(keyType KeyType, network Network) (*Keypair, error) {
+	return generateKeypair(rand.Reader, keyType, network)
+}
+
+func generateKeypair(random io.Reader, keyType KeyType, network Network) (*Keypair, error) {
+	switch keyType {
+	case KeyTypeEd25519:
+		_, key, err := ed25519.GenerateKey(random)
+		if err != nil {
+			return nil, err
+		}
+		return &Keypair{network: network, keyType: keyType, ed25519: key}, nil
+	case KeyTypeECCCompact:
+		// only keys whose y is the smaller root can be rebuilt from x, keep generating until one is
+		for {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), random)
+			if err != nil {
+				return nil, err
+			}
+			if isCompact(key.PublicKey) {
+				return &Keypair{network: network, keyType: keyType, ecc: key}, nil
+			}
+		}
+	}
+	return nil, ErrKeyType
+}
+
+// NewEd25519Keypair wraps an existing ed25519 private key
+func NewEd25519Keypair(key ed25519.PrivateKey, network Network) (*Keypair, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, ErrInvalidKey
+	}
+	return &Keypair{network: network, keyType: KeyTypeEd25519, ed25519: key}, nil
+}
+
+// KeypairFromBytes decodes a key in the Helium binary form, the tag byte followed by the private key
+// and, for ed25519, the public key
+func KeypairFromBytes(b []byte) (*Keypair, error) {
+	if len(b) == 0 {
+		return nil, ErrInvalidKey
+	}
+	network, keyType := Network(b[0]&0xf0), KeyType(b[0]&0x0f)
+	switch keyType {
+	case KeyTypeEd25519:
+		if len(b) != 1+ed25519.PrivateKeySize {
+			return nil, ErrInvalidKey
+		}
+		key := ed25519.NewKeyFromSeed(b[1 : 1+ed25519.SeedSize])
+		if string(key) != string(b[1:]) {
+			return nil, ErrInvalidKey
+		}
+		return NewEd25519Keypair(key, network)
+	case KeyTypeECCCompact:
+		if len(b) != 1+32 && len(b) != 1+64 {
+			return nil, ErrInvalidKey
+		}
+		curve := elliptic.P256()
+		d := new(big.Int).SetBytes(b[1:33])
+		if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+			return nil, ErrInvalidKey
+		}
+		key := &ecdsa.PrivateKey{D: d}
+		key.PublicKey.Curve = curve
+		key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(b[1:33])
+		if !isCompact(key.PublicKey) {
+			return nil, ErrInvalidKey
+		}
+		return &Keypair{network: network, keyType: keyType, ecc: key}, nil
+	}
+	return nil, ErrKeyType
+}
+
+// Bytes returns the key in the Helium binary form read by KeypairFromBytes, keep it secret
+func (k *Keypair) Bytes() []byte {
+	b := []byte{k.tag()}
+	if k.keyType == KeyTypeEd25519 {
+		return append(b, k.ed25519...)
+	}
+	return append(b, append(fixed32(k.ecc.D), fixed32(k.ecc.X)...)...)
+}
+
+// KeyType returns the signature scheme of the key
+func (k *Keypair) KeyType() KeyType {
+	return k.keyType
+}
+
+// Network returns the network of the key
+func (k *Keypair) Network() Network {
+	return k.network
+}
+
+// PublicKey returns the binary public key used in transactions, the tag byte followed by the public key
+func (k *Keypair) PublicKey() []byte {
+	b := []byte{k.tag()}
+	if k.keyType == KeyTypeEd25519 {
+		return append(b, k.ed25519.Public().(ed25519.PublicKey)...)
+	}
+	return append(b, fixed32(k.ecc.X)...)
+}
+
+// Address returns the B58 address of the key, as used for AccountData.Address
+func (k *Keypair) Address() Address {
+	return Address(base58.CheckEncode(0, k.PublicKey()))
+}
+
+// Sign signs message, ed25519 keys sign it directly while ecc_compact keys return a DER encoded
+// ECDSA signature of its sha256. Keypair satisfies txn.Signer.
+func (k *Keypair) Sign(message []byte) ([]byte, error) {
+	if k.keyType == KeyTypeEd25519 {
+		return ed25519.Sign(k.ed25519, message), nil
+	}
+	digest := sha256.Sum256(message)
+	r, s, err := ecdsa.Sign(rand.Reader, k.ecc, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(struct{ R, S *big.Int }{r, s})
+}
+
+func (k *Keypair) tag() byte {
+	return byte(k.network) | byte(k.keyType)
+}
+
+// isCompact reports whether y is the smaller of the two roots for x, so the key can be rebuilt from x alone
+func isCompact(key ecdsa.PublicKey) bool {
+	p := key.Curve.Params().P
+	return key.Y.Cmp(new(big.Int).Sub(p, key.Y)) <= 0
+}
+
+// fixed32 returns n as 32 big endian bytes
+func fixed32(n *big.Int) []byte {
+	b := n.Bytes()
+	return append(make([]byte, 32-len(b)), b...)
+}
@@ -0,0 +1,263 @@
+package helium
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dougkirkley/helium-go/internal/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeypairEd25519(t *testing.T) {
+	k, err := GenerateKeypair(KeyTypeEd25519, Testnet)
+	if !assert.NoError(t, err) {
+		return
+	}
+	version, payload, err := base58.CheckDecode(string(k.Address()))
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0), version)
+	assert.Equal(t, k.PublicKey(), payload)
+	assert.Equal(t, byte(0x11), payload[0])
+
+	sig, err := k.Sign([]byte("message"))
+	assert.NoError(t, err)
+	assert.True(t, ed25519.Verify(ed25519.PublicKey(payload[1:]), []byte("message"), sig))
+
+	decoded, err := KeypairFromBytes(k.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, k.Address(), decoded.Address())
+	assert.Equal(t, Testnet, decoded.Network())
+}
+
+func TestKeypairECCCompact(t *testing.T) {
+	k, err := GenerateKeypair(KeyTypeECCCompact, Mainnet)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, KeyTypeECCCompact, k.KeyType())
+	assert.Len(t, k.PublicKey(), 33)
+	assert.Equal(t, byte(0x00), k.PublicKey()[0])
+	assert.True(t, isCompact(k.ecc.PublicKey))
+
+	sig, err := k.Sign([]byte("message"))
+	assert.NoError(t, err)
+	var rs struct{ R, S *big.Int }
+	_, err = asn1.Unmarshal(sig, &rs)
+	assert.NoError(t, err)
+	digest := sha256.Sum256([]byte("message"))
+	assert.True(t, ecdsa.Verify(&k.ecc.PublicKey, digest[:], rs.R, rs.S))
+
+	decoded, err := KeypairFromBytes(k.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, k.Address(), decoded.Address())
+
+	_, err = KeypairFromBytes([]byte{0x02, 0x01})
+	assert.Equal(t, ErrKeyType, err)
+	_, err = KeypairFromBytes([]byte{0x01, 0x01})
+	assert.Equal(t, ErrInvalidKey, err)
+}
+
+func TestMnemonic(t *testing.T) {
+	// BIP39 reference vectors
+	vectors := map[string]string{
+		"00000000000000000000000000000000":                                 "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f":                                 "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"0000000000000000000000000000000000000000000000000000000000000000": "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff": "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+	}
+	for entropy, mnemonic := range vectors {
+		b, _ := hex.DecodeString(entropy)
+		assert.Equal(t, mnemonic, mnemonicFromEntropy(b))
+		decoded, err := mnemonicToEntropy(mnemonic)
+		assert.NoError(t, err)
+		assert.Equal(t, b, decoded)
+	}
+
+	mnemonic, err := NewMnemonic(24)
+	assert.NoError(t, err)
+	assert.Len(t, strings.Fields(mnemonic), 24)
+	_, err = mnemonicToEntropy(mnemonic)
+	assert.NoError(t, err)
+
+	_, err = NewMnemonic(13)
+	assert.Equal(t, ErrInvalidMnemonic, err)
+	_, err = mnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon able")
+	assert.Equal(t, ErrInvalidMnemonic, err)
+	_, err = mnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon helium")
+	assert.Equal(t, ErrInvalidMnemonic, err)
+	_, err = mnemonicToEntropy(strings.Repeat("abandon ", 24))
+	assert.Equal(t, ErrInvalidMnemonic, err)
+}
+
+func TestKeypairFromMnemonic(t *testing.T) {
+	k, err := KeypairFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", Mainnet)
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the 12 word entropy is repeated to make the 32 byte seed
+	seed := ed25519.NewKeyFromSeed(make([]byte, 32))
+	assert.Equal(t, append([]byte{0x01}, seed.Public().(ed25519.PublicKey)...), k.PublicKey())
+
+	// legacy 12 word phrases have an all zero checksum
+	legacy, err := KeypairFromMnemonic(strings.Repeat("abandon ", 12), Mainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, k.Address(), legacy.Address())
+
+	k, err = KeypairFromMnemonic(strings.Repeat("zoo ", 23)+"vote", Mainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, ed25519.NewKeyFromSeed(bytes.Repeat([]byte{0xff}, 32)), k.ed25519)
+}
+
+// testWalletFile lays out a basic helium-wallet file field by field: kind, salt, iterations, public key, iv,
+// AES-GCM tag and the encrypted key, with the public key as additional data
+func testWalletFile(t *testing.T, kind uint16, key []byte, publicKey []byte, password string) []byte {
+	salt := bytes.Repeat([]byte{0x5a}, 16)
+	iv := bytes.Repeat([]byte{0x17}, 12)
+	block, err := aes.NewCipher(pbkdf2SHA256([]byte(password), salt, 1000, 32))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	aead, _ := cipher.NewGCM(block)
+	sealed := aead.Seal(nil, iv, key, publicKey)
+
+	var file []byte
+	file = append(file, byte(kind), byte(kind>>8))
+	file = append(file, salt...)
+	file = append(file, 0xe8, 0x03, 0x00, 0x00)
+	file = append(file, publicKey...)
+	file = append(file, iv...)
+	file = append(file, sealed[len(sealed)-16:]...)
+	return append(file, sealed[:len(sealed)-16]...)
+}
+
+func TestWalletFile(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x42}, 32)
+	key := ed25519.NewKeyFromSeed(seed)
+	publicKey := append([]byte{0x01}, key.Public().(ed25519.PublicKey)...)
+	address := Address(base58.CheckEncode(0, publicKey))
+
+	for kind, plain := range map[uint16][]byte{
+		0x0002: append([]byte{0x01}, key...),
+		// version 1 wallets encrypt the bare ed25519 key
+		0x0001: key,
+	} {
+		w, err := ReadWallet(bytes.NewReader(testWalletFile(t, kind, plain, publicKey, "hunter2")))
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equal(t, uint32(1000), w.Iterations)
+		assert.Equal(t, address, w.Address())
+
+		k, err := w.Decrypt("hunter2")
+		assert.NoError(t, err)
+		assert.Equal(t, address, k.Address())
+		assert.Equal(t, seed, k.ed25519.Seed())
+
+		_, err = w.Decrypt("hunter3")
+		assert.Equal(t, ErrWalletPassword, err)
+
+		var out bytes.Buffer
+		assert.NoError(t, WriteWallet(&out, w))
+		assert.Equal(t, testWalletFile(t, kind, plain, publicKey, "hunter2"), out.Bytes())
+	}
+
+	for _, kind := range []uint16{0x0003, 0x0101, 0x0102, 0x0103, 0x0200} {
+		_, err := ReadWallet(bytes.NewReader(testWalletFile(t, kind, key, publicKey, "hunter2")))
+		assert.True(t, errors.Is(err, ErrWalletFormat), "kind %#04x", kind)
+	}
+	_, err := ReadWallet(bytes.NewReader([]byte{0x02, 0x00, 0x01}))
+	assert.True(t, errors.Is(err, ErrWalletFormat))
+}
+
+func TestWallet(t *testing.T) {
+	k, err := GenerateKeypair(KeyTypeEd25519, Mainnet)
+	if !assert.NoError(t, err) {
+		return
+	}
+	w, err := EncryptWallet(k, "hunter2")
+	assert.NoError(t, err)
+	assert.Equal(t, k.Address(), w.Address())
+	assert.Equal(t, uint32(walletIterations), w.Iterations)
+
+	path := filepath.Join(t.TempDir(), "wallet.key")
+	assert.NoError(t, SaveWallet(path, w))
+	loaded, err := LoadWallet(path)
+	assert.NoError(t, err)
+
+	decrypted, err := loaded.Decrypt("hunter2")
+	assert.NoError(t, err)
+	assert.Equal(t, k.Bytes(), decrypted.Bytes())
+
+	// the public key is authenticated, a wallet relabelled with another key does not decrypt
+	other, _ := GenerateKeypair(KeyTypeEd25519, Mainnet)
+	loaded.PublicKey = other.PublicKey()
+	_, err = loaded.Decrypt("hunter2")
+	assert.Equal(t, ErrWalletPassword, err)
+}
+
+func TestWalletJSON(t *testing.T) {
+	k, err := GenerateKeypair(KeyTypeEd25519, Mainnet)
+	if !assert.NoError(t, err) {
+		return
+	}
+	w, err := EncryptWallet(k, "hunter2")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, WriteWalletJSON(&out, w))
+	var fields map[string]interface{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &fields))
+	assert.Equal(t, float64(2), fields["version"])
+	assert.Equal(t, string(k.Address()), fields["address"])
+	assert.Equal(t, "pbkdf2-sha256", fields["kdf"])
+	assert.Equal(t, "aes-256-gcm", fields["cipher"])
+
+	path := filepath.Join(t.TempDir(), "wallet.json")
+	assert.NoError(t, ioutil.WriteFile(path, out.Bytes(), 0600))
+	loaded, err := LoadWallet(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, w, loaded)
+	decrypted, err := loaded.Decrypt("hunter2")
+	assert.NoError(t, err)
+	assert.Equal(t, k.Bytes(), decrypted.Bytes())
+
+	// a wallet read from either form writes the same binary file
+	var binary bytes.Buffer
+	assert.NoError(t, WriteWallet(&binary, loaded))
+	fromBinary, err := ReadWallet(&binary)
+	assert.NoError(t, err)
+	assert.Equal(t, w, fromBinary)
+
+	for _, in := range []string{
+		strings.Replace(out.String(), `"version":2`, `"version":3`, 1),
+		strings.Replace(out.String(), `"pbkdf2-sha256"`, `"argon2id"`, 1),
+		strings.Replace(out.String(), `"aes-256-gcm"`, `"aes-128-cbc"`, 1),
+		strings.Replace(out.String(), string(k.Address()), "not-an-address", 1),
+	} {
+		_, err = ReadWallet(strings.NewReader(in))
+		assert.True(t, errors.Is(err, ErrWalletFormat), in)
+	}
+}
+
+func TestPBKDF2(t *testing.T) {
+	// RFC 7914 section 11
+	key := pbkdf2SHA256([]byte("passwd"), []byte("salt"), 1, 64)
+	assert.Equal(t, "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783", hex.EncodeToString(key))
+}
@@ -2,9 +2,10 @@ package helium
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
-	"encoding/json"
 )
 
 // Location handles api endpoint /locations docs located at https://docs.helium.com/api/blockchain/locations
@@ -40,11 +41,16 @@ type LocationInput struct {
 
 // Get gets geographic information for a given location
 func (l *Location) Get(input *LocationInput) (*LocationInfo, error) {
-	resp, err := l.c.Request(http.MethodGet, fmt.Sprintf("/location/%s", input.ID), new(bytes.Buffer), nil)
+	return l.GetWithContext(context.Background(), input)
+}
+
+// GetWithContext is Get with a caller supplied context.
+func (l *Location) GetWithContext(ctx context.Context, input *LocationInput) (*LocationInfo, error) {
+	resp, err := l.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/location/%s", input.ID), new(bytes.Buffer), nil)
 	if err != nil {
 		return &LocationInfo{}, err
 	}
-	//defer resp.Body.Close()
+	defer resp.Body.Close()
 
 	var locationInfo *LocationInfo
 	err = json.NewDecoder(resp.Body).Decode(&locationInfo)
@@ -52,4 +58,4 @@ func (l *Location) Get(input *LocationInput) (*LocationInfo, error) {
 		return &LocationInfo{}, err
 	}
 	return locationInfo, nil
-}
\ No newline at end of file
+}
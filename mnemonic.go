@@ -0,0 +1,109 @@
+package helium
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidMnemonic is returned for a mnemonic with unknown words, the wrong length or a bad checksum
+var ErrInvalidMnemonic = errors.New("helium: invalid mnemonic")
+
+// NewMnemonic returns a random BIP39 mnemonic of 12 or 24 words
+func NewMnemonic(words int) (string, error) {
+	if words != 12 && words != 24 {
+		return "", ErrInvalidMnemonic
+	}
+	entropy := make([]byte, words*4/3)
+	_, err := rand.Read(entropy)
+	if err != nil {
+		return "", err
+	}
+	return mnemonicFromEntropy(entropy), nil
+}
+
+// KeypairFromMnemonic derives the ed25519 key of a 12 or 24 word mnemonic the way the Helium
+// wallets do. The 24 word entropy is the key seed, the 12 word entropy is used twice over.
+// 12 word phrases from older Helium apps carry an all zero checksum, these are accepted too.
+func KeypairFromMnemonic(mnemonic string, network Network) (*Keypair, error) {
+	entropy, err := mnemonicToEntropy(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	seed := entropy
+	if len(entropy) == 16 {
+		seed = append(append([]byte{}, entropy...), entropy...)
+	}
+	return NewEd25519Keypair(ed25519.NewKeyFromSeed(seed), network)
+}
+
+func mnemonicFromEntropy(entropy []byte) string {
+	sum := sha256.Sum256(entropy)
+	bits := append(append([]byte{}, entropy...), sum[0])
+	words := make([]string, len(entropy)*8/32*3)
+	for i := range words {
+		words[i] = bip39Words[readBits(bits, i*11, 11)]
+	}
+	return strings.Join(words, " ")
+}
+
+func mnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) != 12 && len(words) != 24 {
+		return nil, ErrInvalidMnemonic
+	}
+	bits := make([]byte, (len(words)*11+7)/8)
+	for i, word := range words {
+		index := wordIndex(word)
+		if index < 0 {
+			return nil, ErrInvalidMnemonic
+		}
+		writeBits(bits, i*11, 11, index)
+	}
+	size := len(words) * 4 / 3
+	checksumBits := len(words) / 3
+	entropy := bits[:size]
+	checksum := readBits(bits, size*8, checksumBits)
+	sum := sha256.Sum256(entropy)
+	if checksum != int(sum[0]>>(8-checksumBits)) && !(len(words) == 12 && checksum == 0) {
+		return nil, ErrInvalidMnemonic
+	}
+	return entropy, nil
+}
+
+func wordIndex(word string) int {
+	lo, hi := 0, len(bip39Words)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if bip39Words[mid] < word {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(bip39Words) && bip39Words[lo] == word {
+		return lo
+	}
+	return -1
+}
+
+// readBits reads n bits starting at bit offset as a big endian number
+func readBits(b []byte, offset, n int) int {
+	v := 0
+	for i := offset; i < offset+n; i++ {
+		v = v<<1 | int(b[i/8]>>(7-uint(i%8))&1)
+	}
+	return v
+}
+
+// writeBits writes the low n bits of v at bit offset, big endian
+func writeBits(b []byte, offset, n, v int) {
+	for i := 0; i < n; i++ {
+		if v>>(uint(n-1-i))&1 == 1 {
+			pos := offset + i
+			b[pos/8] |= 1 << (7 - uint(pos%8))
+		}
+	}
+}
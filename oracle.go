@@ -2,6 +2,7 @@ package helium
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -43,19 +44,19 @@ type OraclePriceStatsData struct {
 }
 
 type OraclePriceActivity struct {
-	Cursor string `json:"cursor"`
+	Cursor string                    `json:"cursor"`
 	Data   []OraclePriceActivityData `json:"data"`
 }
 
 type OraclePriceActivityData struct {
-	BlockHeight int    `json:"block_height"`
-	Fee         int    `json:"fee"`
-	Hash        string `json:"hash"`
-	Height      int    `json:"height"`
-	Price       int    `json:"price"`
-	PublicKey   string `json:"public_key"`
-	Time        int    `json:"time"`
-	Type        string `json:"type"`
+	BlockHeight int       `json:"block_height"`
+	Fee         DC        `json:"fee"`
+	Hash        string    `json:"hash"`
+	Height      int       `json:"height"`
+	Price       int       `json:"price"`
+	PublicKey   Address   `json:"public_key"`
+	Time        Timestamp `json:"time"`
+	Type        string    `json:"type"`
 }
 
 type OraclePriceListInput struct {
@@ -63,8 +64,8 @@ type OraclePriceListInput struct {
 }
 
 type OraclePriceStatsInput struct {
-	MinTime string
-	MaxTime string
+	MinTime TimeBound
+	MaxTime TimeBound
 }
 
 type OraclePriceBlockInput struct {
@@ -77,9 +78,14 @@ type OraclePriceActivityInput struct {
 
 // List The current and historical Oracle Prices and at which block they took effect.
 func (o *Oracle) List(input *OraclePriceListInput) (*OraclePrices, error) {
+	return o.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (o *Oracle) ListWithContext(ctx context.Context, input *OraclePriceListInput) (*OraclePrices, error) {
 	params := make(map[string]string)
 	params["cursor"] = input.Cursor
-	resp, err := o.c.Request(http.MethodGet, "/oracle/prices", new(bytes.Buffer), params)
+	resp, err := o.c.RequestContext(ctx, http.MethodGet, "/oracle/prices", new(bytes.Buffer), params)
 	if err != nil {
 		return &OraclePrices{}, err
 	}
@@ -93,9 +99,21 @@ func (o *Oracle) List(input *OraclePriceListInput) (*OraclePrices, error) {
 	return oraclePrices, nil
 }
 
+// ListAll iterates over the current and historical Oracle Prices.
+func (o *Oracle) ListAll(opts ...PagerOption) *OraclePricePager {
+	return NewOraclePricePager(func(ctx context.Context, cursor string) (*OraclePrices, error) {
+		return o.ListWithContext(ctx, &OraclePriceListInput{Cursor: cursor})
+	}, opts...)
+}
+
 // Current The current Oracle Price and at which block it took effect.
 func (o *Oracle) Current() (*OraclePrice, error) {
-	resp, err := o.c.Request(http.MethodGet, "/oracle/prices/current", new(bytes.Buffer), nil)
+	return o.CurrentWithContext(context.Background())
+}
+
+// CurrentWithContext is Current with a caller supplied context.
+func (o *Oracle) CurrentWithContext(ctx context.Context) (*OraclePrice, error) {
+	resp, err := o.c.RequestContext(ctx, http.MethodGet, "/oracle/prices/current", new(bytes.Buffer), nil)
 	if err != nil {
 		return &OraclePrice{}, err
 	}
@@ -111,10 +129,14 @@ func (o *Oracle) Current() (*OraclePrice, error) {
 
 // Stats Gets statistics on Oracle prices.
 func (o *Oracle) Stats(input *OraclePriceStatsInput) (*OraclePriceStats, error) {
+	return o.StatsWithContext(context.Background(), input)
+}
+
+// StatsWithContext is Stats with a caller supplied context.
+func (o *Oracle) StatsWithContext(ctx context.Context, input *OraclePriceStatsInput) (*OraclePriceStats, error) {
 	params := make(map[string]string)
-	params["min_time"] = input.MinTime
-	params["max_time"] = input.MaxTime
-	resp, err := o.c.Request(http.MethodGet, "/oracle/prices/stats", new(bytes.Buffer), params)
+	timeParams(params, input.MinTime, input.MaxTime)
+	resp, err := o.c.RequestContext(ctx, http.MethodGet, "/oracle/prices/stats", new(bytes.Buffer), params)
 	if err != nil {
 		return &OraclePriceStats{}, err
 	}
@@ -130,7 +152,12 @@ func (o *Oracle) Stats(input *OraclePriceStatsInput) (*OraclePriceStats, error)
 
 // Block Provides the oracle price at a specific block and at which block it initially took effect.
 func (o *Oracle) Block(input *OraclePriceBlockInput) (*OraclePrice, error) {
-	resp, err := o.c.Request(http.MethodGet, fmt.Sprintf("/oracle/prices/%s", input.ID), new(bytes.Buffer), nil)
+	return o.BlockWithContext(context.Background(), input)
+}
+
+// BlockWithContext is Block with a caller supplied context.
+func (o *Oracle) BlockWithContext(ctx context.Context, input *OraclePriceBlockInput) (*OraclePrice, error) {
+	resp, err := o.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/oracle/prices/%s", input.ID), new(bytes.Buffer), nil)
 	if err != nil {
 		return &OraclePrice{}, err
 	}
@@ -146,9 +173,14 @@ func (o *Oracle) Block(input *OraclePriceBlockInput) (*OraclePrice, error) {
 
 // Activity List oracle price report transactions for all oracle keys.
 func (o *Oracle) Activity(input *OraclePriceActivityInput) (*OraclePriceActivity, error) {
+	return o.ActivityWithContext(context.Background(), input)
+}
+
+// ActivityWithContext is Activity with a caller supplied context.
+func (o *Oracle) ActivityWithContext(ctx context.Context, input *OraclePriceActivityInput) (*OraclePriceActivity, error) {
 	params := make(map[string]string)
 	params["cursor"] = input.Cursor
-	resp, err := o.c.Request(http.MethodGet, "/oracle/prices/activity", new(bytes.Buffer), params)
+	resp, err := o.c.RequestContext(ctx, http.MethodGet, "/oracle/prices/activity", new(bytes.Buffer), params)
 	if err != nil {
 		return &OraclePriceActivity{}, err
 	}
@@ -160,4 +192,11 @@ func (o *Oracle) Activity(input *OraclePriceActivityInput) (*OraclePriceActivity
 		return &OraclePriceActivity{}, err
 	}
 	return oraclePriceActivity, nil
-}
\ No newline at end of file
+}
+
+// ActivityAll iterates over every oracle price report transaction.
+func (o *Oracle) ActivityAll(opts ...PagerOption) *OraclePriceActivityPager {
+	return NewOraclePriceActivityPager(func(ctx context.Context, cursor string) (*OraclePriceActivity, error) {
+		return o.ActivityWithContext(ctx, &OraclePriceActivityInput{Cursor: cursor})
+	}, opts...)
+}
@@ -0,0 +1,202 @@
+package helium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Oui handles the OUIs, the routers registered to receive packets, at /ouis
+type Oui struct {
+	c *Client
+}
+
+// Oui returns the Oui client
+func (c *Client) Oui() *Oui {
+	return &Oui{c}
+}
+
+// DevAddr is a LoRaWAN device address
+type DevAddr uint32
+
+// devAddrPrefix is the NwkID of Helium's NetID 0x000024 in the top 7 bits of a DevAddr
+const devAddrPrefix = 0x48000000
+
+// subnetMaskBits is the width of a subnet mask, subnet sizes are multiples of 8 up to 1<<25
+const subnetMaskBits = 0x7FFFFF
+
+// String formats the DevAddr as 8 hex digits as LoRaWAN tools do
+func (d DevAddr) String() string {
+	return fmt.Sprintf("%08X", uint32(d))
+}
+
+type Ouis struct {
+	Data   []OuiData `json:"data"`
+	Cursor string    `json:"cursor"`
+}
+
+// Subnets is a block of device addresses allocated to an OUI, Base is the offset of its first address
+// in Helium's DevAddr space and Mask encodes its size
+type Subnets struct {
+	Mask int `json:"mask"`
+	Base int `json:"base"`
+}
+
+// Size returns the number of device addresses in the subnet
+func (s Subnets) Size() int {
+	return ((s.Mask ^ subnetMaskBits) << 2) + 4
+}
+
+// First returns the first device address in the subnet
+func (s Subnets) First() DevAddr {
+	return DevAddr(devAddrPrefix | uint32(s.Base))
+}
+
+// Last returns the last device address in the subnet
+func (s Subnets) Last() DevAddr {
+	return s.First() + DevAddr(s.Size()-1)
+}
+
+// Contains reports whether addr is in the subnet
+func (s Subnets) Contains(addr DevAddr) bool {
+	return addr >= s.First() && addr <= s.Last()
+}
+
+type OuiData struct {
+	Subnets   []Subnets `json:"subnets"`
+	Owner     Address   `json:"owner"`
+	Oui       int       `json:"oui"`
+	Nonce     int       `json:"nonce"`
+	Block     int       `json:"block"`
+	Addresses []Address `json:"addresses"`
+}
+
+// DevAddrs returns the number of device addresses allocated to the OUI
+func (o OuiData) DevAddrs() int {
+	var n int
+	for _, subnet := range o.Subnets {
+		n += subnet.Size()
+	}
+	return n
+}
+
+// Contains reports whether addr is allocated to the OUI
+func (o OuiData) Contains(addr DevAddr) bool {
+	for _, subnet := range o.Subnets {
+		if subnet.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+type OuiInfo struct {
+	Data OuiData `json:"data"`
+}
+
+type OuiStats struct {
+	Data OuiStatsData `json:"data"`
+}
+
+type OuiStatsData struct {
+	Count int `json:"count"`
+}
+
+type OuiListInput struct {
+	Cursor string
+}
+
+// List Lists every registered OUI.
+func (o *Oui) List(input *OuiListInput) (*Ouis, error) {
+	return o.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (o *Oui) ListWithContext(ctx context.Context, input *OuiListInput) (*Ouis, error) {
+	params := make(map[string]string)
+	params["cursor"] = input.Cursor
+	resp, err := o.c.RequestContext(ctx, http.MethodGet, "/ouis", new(bytes.Buffer), params)
+	if err != nil {
+		return &Ouis{}, err
+	}
+	defer resp.Body.Close()
+
+	var ouis *Ouis
+	err = json.NewDecoder(resp.Body).Decode(&ouis)
+	if err != nil {
+		return &Ouis{}, err
+	}
+	return ouis, nil
+}
+
+// ListAll iterates over every registered OUI.
+func (o *Oui) ListAll(opts ...PagerOption) *OuiPager {
+	return NewOuiPager(func(ctx context.Context, cursor string) (*Ouis, error) {
+		return o.ListWithContext(ctx, &OuiListInput{Cursor: cursor})
+	}, opts...)
+}
+
+// Get Fetches an OUI by its number.
+func (o *Oui) Get(oui int) (*OuiInfo, error) {
+	return o.GetWithContext(context.Background(), oui)
+}
+
+// GetWithContext is Get with a caller supplied context.
+func (o *Oui) GetWithContext(ctx context.Context, oui int) (*OuiInfo, error) {
+	resp, err := o.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/ouis/%d", oui), new(bytes.Buffer), nil)
+	if err != nil {
+		return &OuiInfo{}, err
+	}
+	defer resp.Body.Close()
+
+	var ouiInfo *OuiInfo
+	err = json.NewDecoder(resp.Body).Decode(&ouiInfo)
+	if err != nil {
+		return &OuiInfo{}, err
+	}
+	return ouiInfo, nil
+}
+
+// Last Fetches the most recently registered OUI.
+func (o *Oui) Last() (*OuiInfo, error) {
+	return o.LastWithContext(context.Background())
+}
+
+// LastWithContext is Last with a caller supplied context.
+func (o *Oui) LastWithContext(ctx context.Context) (*OuiInfo, error) {
+	resp, err := o.c.RequestContext(ctx, http.MethodGet, "/ouis/last", new(bytes.Buffer), nil)
+	if err != nil {
+		return &OuiInfo{}, err
+	}
+	defer resp.Body.Close()
+
+	var ouiInfo *OuiInfo
+	err = json.NewDecoder(resp.Body).Decode(&ouiInfo)
+	if err != nil {
+		return &OuiInfo{}, err
+	}
+	return ouiInfo, nil
+}
+
+// Stats Returns the number of registered OUIs.
+func (o *Oui) Stats() (*OuiStats, error) {
+	return o.StatsWithContext(context.Background())
+}
+
+// StatsWithContext is Stats with a caller supplied context.
+func (o *Oui) StatsWithContext(ctx context.Context) (*OuiStats, error) {
+	resp, err := o.c.RequestContext(ctx, http.MethodGet, "/ouis/stats", new(bytes.Buffer), nil)
+	if err != nil {
+		return &OuiStats{}, err
+	}
+	defer resp.Body.Close()
+
+	var ouiStats *OuiStats
+	err = json.NewDecoder(resp.Body).Decode(&ouiStats)
+	if err != nil {
+		return &OuiStats{}, err
+	}
+	return ouiStats, nil
+}
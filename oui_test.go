@@ -0,0 +1,41 @@
+package helium
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubnets(t *testing.T) {
+	// the smallest subnet, 8 addresses
+	subnet := Subnets{Base: 8, Mask: 0x7FFFFE}
+	assert.Equal(t, 8, subnet.Size())
+	assert.Equal(t, "48000008", subnet.First().String())
+	assert.Equal(t, "4800000F", subnet.Last().String())
+	assert.True(t, subnet.Contains(0x4800000C))
+	assert.False(t, subnet.Contains(0x48000010))
+	assert.False(t, subnet.Contains(0x08000008))
+
+	// 1024 addresses
+	subnet = Subnets{Base: 1024, Mask: 0x7FFF00}
+	assert.Equal(t, 1024, subnet.Size())
+	assert.Equal(t, DevAddr(0x480007FF), subnet.Last())
+
+	oui := OuiData{Subnets: []Subnets{{Base: 0, Mask: 0x7FFFFE}, subnet}}
+	assert.Equal(t, 1032, oui.DevAddrs())
+	assert.True(t, oui.Contains(0x48000400))
+	assert.False(t, oui.Contains(0x48000008))
+}
+
+func TestOuiGet(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ouis/2", r.URL.Path)
+		w.Write([]byte(`{"data":{"oui":2,"owner":"` + testAccount + `","subnets":[{"base":8,"mask":8388606}],"addresses":[]}}`))
+	}))
+
+	oui, err := client.Oui().Get(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, oui.Data.Oui)
+	assert.Equal(t, DevAddr(0x48000008), oui.Data.Subnets[0].First())
+}
@@ -0,0 +1,129 @@
+package helium
+
+import (
+	"context"
+	"fmt"
+)
+
+// PagerOption configures a pager
+type PagerOption func(*Pager)
+
+// MaxItems stops a pager after n items
+func MaxItems(n int) PagerOption {
+	return func(p *Pager) {
+		p.maxItems = n
+	}
+}
+
+// MaxPages stops a pager after fetching n pages
+func MaxPages(n int) PagerOption {
+	return func(p *Pager) {
+		p.maxPages = n
+	}
+}
+
+// FromCursor starts a pager at the page for cursor instead of the first page
+func FromCursor(cursor string) PagerOption {
+	return func(p *Pager) {
+		p.cursor = cursor
+	}
+}
+
+// pageFunc fetches the page for cursor, returning its length and the cursor for the following page
+type pageFunc func(ctx context.Context, cursor string) (int, string, error)
+
+// Pager walks a cursor paginated endpoint one item at a time, fetching pages as needed.
+// The typed pagers such as HotspotPager embed it and add an Item method.
+//
+//	hotspots := client.Hotspot().ListAll()
+//	for hotspots.Next(ctx) {
+//		fmt.Println(hotspots.Item().Name)
+//	}
+//	if err := hotspots.Err(); err != nil {
+//		return err
+//	}
+type Pager struct {
+	fetch      pageFunc
+	cursor     string
+	pageCursor string
+	started    bool
+	size       int
+	index      int
+	skip       int
+	pages      int
+	items      int
+	maxItems   int
+	maxPages   int
+	err        error
+
+	endpoint string
+	params   map[string]string
+	resume   *Checkpoint
+}
+
+func (p *Pager) init(fetch pageFunc, opts []PagerOption) {
+	p.fetch = fetch
+	p.index = -1
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// Next advances to the next item, fetching the next page when the current one is used up.
+// It returns false when there are no more items, a limit is reached, or a request fails.
+func (p *Pager) Next(ctx context.Context) bool {
+	if p.err != nil || (p.maxItems > 0 && p.items >= p.maxItems) {
+		return false
+	}
+	p.index++
+	for p.index >= p.size {
+		if p.started && len(p.cursor) == 0 {
+			return false
+		}
+		if p.maxPages > 0 && p.pages >= p.maxPages {
+			return false
+		}
+		page := &pageRequest{}
+		size, cursor, err := p.fetch(context.WithValue(ctx, pageRequestKey{}, page), p.cursor)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		if p.resume != nil && len(p.resume.Endpoint) > 0 && !p.resume.matches(page.endpoint, page.params) {
+			p.err = fmt.Errorf("%w: checkpoint is for %s, pager requested %s", ErrCheckpointMismatch, p.resume.Endpoint, page.endpoint)
+			return false
+		}
+		p.resume = nil
+		p.endpoint = page.endpoint
+		p.params = page.params
+		p.started = true
+		p.pages++
+		p.size = size
+		p.index = p.skip
+		p.skip = 0
+		p.pageCursor = p.cursor
+		p.cursor = cursor
+	}
+	p.items++
+	return true
+}
+
+// Err returns the error that stopped the pager, if any
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// Cursor returns the cursor of the page after the current one, it is empty once the last page has been fetched
+func (p *Pager) Cursor() string {
+	return p.cursor
+}
+
+// Pages returns the number of pages fetched so far
+func (p *Pager) Pages() int {
+	return p.pages
+}
+
+// Items returns the number of items returned by Next so far
+func (p *Pager) Items() int {
+	return p.items
+}
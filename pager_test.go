@@ -0,0 +1,106 @@
+package helium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedHotspots serves hotspots h0..h(n-1) in pages of size, with an empty first page like the api sometimes returns
+func pagedHotspots(n int, size int, requests *int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		start := -1
+		fmt.Sscanf(r.URL.Query().Get("cursor"), "c%d", &start)
+		if start < 0 {
+			w.Write([]byte(`{"data":[],"cursor":"c0"}`))
+			return
+		}
+		page := &Hotspots{Data: []HotspotData{}}
+		for i := start; i < start+size && i < n; i++ {
+			page.Data = append(page.Data, HotspotData{Name: fmt.Sprintf("h%d", i)})
+		}
+		if start+size < n {
+			page.Cursor = fmt.Sprintf("c%d", start+size)
+		}
+		writeJSON(w, page)
+	})
+}
+
+func TestPager(t *testing.T) {
+	var requests int32
+	client := testClient(t, pagedHotspots(7, 3, &requests))
+
+	pager := client.Hotspot().ListAll()
+	var addresses []string
+	for pager.Next(context.Background()) {
+		addresses = append(addresses, pager.Item().Name)
+	}
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []string{"h0", "h1", "h2", "h3", "h4", "h5", "h6"}, addresses)
+	assert.Equal(t, 4, pager.Pages())
+	assert.Equal(t, 7, pager.Items())
+	assert.Equal(t, "", pager.Cursor())
+	assert.False(t, pager.Next(context.Background()))
+	assert.Equal(t, int32(4), requests)
+}
+
+func TestPagerLimits(t *testing.T) {
+	var requests int32
+	client := testClient(t, pagedHotspots(7, 3, &requests))
+
+	pager := client.Hotspot().ListAll(MaxItems(4))
+	for pager.Next(context.Background()) {
+	}
+	assert.Equal(t, 4, pager.Items())
+	assert.Equal(t, "c6", pager.Cursor())
+
+	pager = client.Hotspot().ListAll(MaxPages(2))
+	for pager.Next(context.Background()) {
+	}
+	assert.Equal(t, 3, pager.Items())
+	assert.Equal(t, 2, pager.Pages())
+
+	pager = client.Hotspot().ListAll(FromCursor("c3"))
+	assert.True(t, pager.Next(context.Background()))
+	assert.Equal(t, "h3", pager.Item().Name)
+}
+
+func TestPagerError(t *testing.T) {
+	var requests int32
+	pages := pagedHotspots(7, 3, &requests)
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "c3" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		pages.ServeHTTP(w, r)
+	}))
+
+	pager := client.Hotspot().ListAll()
+	count := 0
+	for pager.Next(context.Background()) {
+		count++
+	}
+	assert.Equal(t, 3, count)
+	assert.True(t, errors.Is(pager.Err(), ErrServer))
+}
+
+func TestPagerCancel(t *testing.T) {
+	var requests int32
+	client := testClient(t, pagedHotspots(7, 3, &requests))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pager := client.Hotspot().ListAll()
+	assert.True(t, pager.Next(ctx))
+	cancel()
+	for pager.Next(ctx) {
+	}
+	assert.True(t, errors.Is(pager.Err(), context.Canceled))
+	assert.Equal(t, 3, pager.Items())
+}
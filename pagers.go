@@ -0,0 +1,555 @@
+package helium
+
+import (
+	"context"
+)
+
+// AccountPager iterates over accounts
+type AccountPager struct {
+	Pager
+	page []AccountData
+}
+
+// NewAccountPager returns a pager over the pages returned by fetch
+func NewAccountPager(fetch func(ctx context.Context, cursor string) (*Accounts, error), opts ...PagerOption) *AccountPager {
+	p := &AccountPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *AccountPager) Item() AccountData {
+	return p.page[p.index]
+}
+
+// HotspotPager iterates over hotspots
+type HotspotPager struct {
+	Pager
+	page []HotspotData
+}
+
+// NewHotspotPager returns a pager over the pages returned by fetch
+func NewHotspotPager(fetch func(ctx context.Context, cursor string) (*Hotspots, error), opts ...PagerOption) *HotspotPager {
+	p := &HotspotPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *HotspotPager) Item() HotspotData {
+	return p.page[p.index]
+}
+
+// OuiPager iterates over OUIs
+type OuiPager struct {
+	Pager
+	page []OuiData
+}
+
+// NewOuiPager returns a pager over the pages returned by fetch
+func NewOuiPager(fetch func(ctx context.Context, cursor string) (*Ouis, error), opts ...PagerOption) *OuiPager {
+	p := &OuiPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *OuiPager) Item() OuiData {
+	return p.page[p.index]
+}
+
+// ActivityPager iterates over account activity
+type ActivityPager struct {
+	Pager
+	page []ActivityData
+}
+
+// NewActivityPager returns a pager over the pages returned by fetch
+func NewActivityPager(fetch func(ctx context.Context, cursor string) (*Activity, error), opts ...PagerOption) *ActivityPager {
+	p := &ActivityPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *ActivityPager) Item() ActivityData {
+	return p.page[p.index]
+}
+
+// HotspotActivityPager iterates over hotspot activity
+type HotspotActivityPager struct {
+	Pager
+	page []HotspotsActivityData
+}
+
+// NewHotspotActivityPager returns a pager over the pages returned by fetch
+func NewHotspotActivityPager(fetch func(ctx context.Context, cursor string) (*HotspotsActivity, error), opts ...PagerOption) *HotspotActivityPager {
+	p := &HotspotActivityPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *HotspotActivityPager) Item() HotspotsActivityData {
+	return p.page[p.index]
+}
+
+// ElectionPager iterates over elections
+type ElectionPager struct {
+	Pager
+	page []ElectionData
+}
+
+// NewElectionPager returns a pager over the pages returned by fetch
+func NewElectionPager(fetch func(ctx context.Context, cursor string) (*Elections, error), opts ...PagerOption) *ElectionPager {
+	p := &ElectionPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *ElectionPager) Item() ElectionData {
+	return p.page[p.index]
+}
+
+// ChallengePager iterates over challenges
+type ChallengePager struct {
+	Pager
+	page []ChallengeData
+}
+
+// NewChallengePager returns a pager over the pages returned by fetch
+func NewChallengePager(fetch func(ctx context.Context, cursor string) (*Challenges, error), opts ...PagerOption) *ChallengePager {
+	p := &ChallengePager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *ChallengePager) Item() ChallengeData {
+	return p.page[p.index]
+}
+
+// PendingTransactionPager iterates over pending transactions
+type PendingTransactionPager struct {
+	Pager
+	page []PendingTransactionData
+}
+
+// NewPendingTransactionPager returns a pager over the pages returned by fetch
+func NewPendingTransactionPager(fetch func(ctx context.Context, cursor string) (*PendingTransactions, error), opts ...PagerOption) *PendingTransactionPager {
+	p := &PendingTransactionPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *PendingTransactionPager) Item() PendingTransactionData {
+	return p.page[p.index]
+}
+
+// RewardPager iterates over rewards
+type RewardPager struct {
+	Pager
+	page []RewardData
+}
+
+// NewRewardPager returns a pager over the pages returned by fetch
+func NewRewardPager(fetch func(ctx context.Context, cursor string) (*Rewards, error), opts ...PagerOption) *RewardPager {
+	p := &RewardPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *RewardPager) Item() RewardData {
+	return p.page[p.index]
+}
+
+// CityPager iterates over cities
+type CityPager struct {
+	Pager
+	page []CityData
+}
+
+// NewCityPager returns a pager over the pages returned by fetch
+func NewCityPager(fetch func(ctx context.Context, cursor string) (*Cities, error), opts ...PagerOption) *CityPager {
+	p := &CityPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *CityPager) Item() CityData {
+	return p.page[p.index]
+}
+
+// BlockPager iterates over blocks
+type BlockPager struct {
+	Pager
+	page []BlockData
+}
+
+// NewBlockPager returns a pager over the pages returned by fetch
+func NewBlockPager(fetch func(ctx context.Context, cursor string) (*Blocks, error), opts ...PagerOption) *BlockPager {
+	p := &BlockPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *BlockPager) Item() BlockData {
+	return p.page[p.index]
+}
+
+// TransactionPager iterates over transactions
+type TransactionPager struct {
+	Pager
+	page []Txn
+}
+
+// NewTransactionPager returns a pager over the pages returned by fetch
+func NewTransactionPager(fetch func(ctx context.Context, cursor string) (*Transactions, error), opts ...PagerOption) *TransactionPager {
+	p := &TransactionPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *TransactionPager) Item() Txn {
+	return p.page[p.index]
+}
+
+// OraclePricePager iterates over oracle prices
+type OraclePricePager struct {
+	Pager
+	page []OraclePriceData
+}
+
+// NewOraclePricePager returns a pager over the pages returned by fetch
+func NewOraclePricePager(fetch func(ctx context.Context, cursor string) (*OraclePrices, error), opts ...PagerOption) *OraclePricePager {
+	p := &OraclePricePager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *OraclePricePager) Item() OraclePriceData {
+	return p.page[p.index]
+}
+
+// OraclePriceActivityPager iterates over oracle price reports
+type OraclePriceActivityPager struct {
+	Pager
+	page []OraclePriceActivityData
+}
+
+// NewOraclePriceActivityPager returns a pager over the pages returned by fetch
+func NewOraclePriceActivityPager(fetch func(ctx context.Context, cursor string) (*OraclePriceActivity, error), opts ...PagerOption) *OraclePriceActivityPager {
+	p := &OraclePriceActivityPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *OraclePriceActivityPager) Item() OraclePriceActivityData {
+	return p.page[p.index]
+}
+
+// ValidatorPager iterates over validators
+type ValidatorPager struct {
+	Pager
+	page []ValidatorData
+}
+
+// NewValidatorPager returns a pager over the pages returned by fetch
+func NewValidatorPager(fetch func(ctx context.Context, cursor string) (*Validators, error), opts ...PagerOption) *ValidatorPager {
+	p := &ValidatorPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *ValidatorPager) Item() ValidatorData {
+	return p.page[p.index]
+}
+
+// ValidatorActivityPager iterates over validator activity
+type ValidatorActivityPager struct {
+	Pager
+	page []ValidatorActivityData
+}
+
+// NewValidatorActivityPager returns a pager over the pages returned by fetch
+func NewValidatorActivityPager(fetch func(ctx context.Context, cursor string) (*ValidatorActivity, error), opts ...PagerOption) *ValidatorActivityPager {
+	p := &ValidatorActivityPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *ValidatorActivityPager) Item() ValidatorActivityData {
+	return p.page[p.index]
+}
+
+// ValidatorRewardPager iterates over validator rewards
+type ValidatorRewardPager struct {
+	Pager
+	page []ValidatorRewardData
+}
+
+// NewValidatorRewardPager returns a pager over the pages returned by fetch
+func NewValidatorRewardPager(fetch func(ctx context.Context, cursor string) (*ValidatorRewards, error), opts ...PagerOption) *ValidatorRewardPager {
+	p := &ValidatorRewardPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *ValidatorRewardPager) Item() ValidatorRewardData {
+	return p.page[p.index]
+}
+
+// RewardTransactionPager iterates over network reward transactions
+type RewardTransactionPager struct {
+	Pager
+	page []Txn
+}
+
+// NewRewardTransactionPager returns a pager over the pages returned by fetch
+func NewRewardTransactionPager(fetch func(ctx context.Context, cursor string) (*RewardTransactions, error), opts ...PagerOption) *RewardTransactionPager {
+	p := &RewardTransactionPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *RewardTransactionPager) Item() Txn {
+	return p.page[p.index]
+}
+
+// VarActivityPager iterates over vars transactions
+type VarActivityPager struct {
+	Pager
+	page []Txn
+}
+
+// NewVarActivityPager returns a pager over the pages returned by fetch
+func NewVarActivityPager(fetch func(ctx context.Context, cursor string) (*VarActivity, error), opts ...PagerOption) *VarActivityPager {
+	p := &VarActivityPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *VarActivityPager) Item() Txn {
+	return p.page[p.index]
+}
+
+// StateChannelPager iterates over state channel close transactions
+type StateChannelPager struct {
+	Pager
+	page []StateChannelCloseV1
+}
+
+// NewStateChannelPager returns a pager over the pages returned by fetch
+func NewStateChannelPager(fetch func(ctx context.Context, cursor string) (*StateChannels, error), opts ...PagerOption) *StateChannelPager {
+	p := &StateChannelPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *StateChannelPager) Item() StateChannelCloseV1 {
+	return p.page[p.index]
+}
+
+// DCBurnPager iterates over data credit burns
+type DCBurnPager struct {
+	Pager
+	page []DCBurnData
+}
+
+// NewDCBurnPager returns a pager over the pages returned by fetch
+func NewDCBurnPager(fetch func(ctx context.Context, cursor string) (*DCBurns, error), opts ...PagerOption) *DCBurnPager {
+	p := &DCBurnPager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *DCBurnPager) Item() DCBurnData {
+	return p.page[p.index]
+}
+
+// RolePager iterates over transaction roles
+type RolePager struct {
+	Pager
+	page []RoleData
+}
+
+// NewRolePager returns a pager over the pages returned by fetch
+func NewRolePager(fetch func(ctx context.Context, cursor string) (*Roles, error), opts ...PagerOption) *RolePager {
+	p := &RolePager{}
+	p.init(func(ctx context.Context, cursor string) (int, string, error) {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		p.page = page.Data
+		return len(p.page), page.Cursor, nil
+	}, opts)
+	return p
+}
+
+// Item returns the current item, it is only valid after Next returns true
+func (p *RolePager) Item() RoleData {
+	return p.page[p.index]
+}
@@ -2,11 +2,11 @@ package helium
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 type PendingTransaction struct {
@@ -18,17 +18,33 @@ func (c *Client) PendingTransaction() *PendingTransaction {
 }
 
 type PendingTransactions struct {
-	Data []PendingTransactionData `json:"data"`
+	Data   []PendingTransactionData `json:"data"`
+	Cursor string                   `json:"cursor"`
 }
 
 type PendingTransactionData struct {
-	UpdatedAt    time.Time `json:"updated_at"`
-	Type         string    `json:"type"`
+	UpdatedAt    Timestamp `json:"updated_at"`
+	Type         TxnType   `json:"type"`
 	Txn          Txn       `json:"txn"`
 	Status       string    `json:"status"`
 	Hash         string    `json:"hash"`
 	FailedReason string    `json:"failed_reason"`
-	CreatedAt    time.Time `json:"created_at"`
+	CreatedAt    Timestamp `json:"created_at"`
+}
+
+// UnmarshalJSON decodes Txn into the concrete type named by Type
+func (d *PendingTransactionData) UnmarshalJSON(data []byte) error {
+	type alias PendingTransactionData
+	aux := struct {
+		*alias
+		Txn json.RawMessage `json:"txn"`
+	}{alias: (*alias)(d)}
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+	d.Txn, err = decodePendingTxn(d.Type, d.Hash, aux.Txn)
+	return err
 }
 
 type SubmittedHash struct {
@@ -48,12 +64,18 @@ type PendingTransactionInput struct {
 }
 
 type TransactionSubmitInput struct {
+	// Transaction is the serialised blockchain_txn, as returned by txn.Encode
 	Transaction string
 }
 
 // Get Fetches the status for a given pending transaction hash.
 func (t *PendingTransaction) Get(input *PendingTransactionInput) (*PendingTransactions, error) {
-	resp, err := t.c.Request(http.MethodGet, fmt.Sprintf("/pending_transactions/%s", input.ID), new(bytes.Buffer), nil)
+	return t.GetWithContext(context.Background(), input)
+}
+
+// GetWithContext is Get with a caller supplied context.
+func (t *PendingTransaction) GetWithContext(ctx context.Context, input *PendingTransactionInput) (*PendingTransactions, error) {
+	resp, err := t.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/pending_transactions/%s", input.ID), new(bytes.Buffer), nil)
 	if err != nil {
 		return &PendingTransactions{}, err
 	}
@@ -69,6 +91,11 @@ func (t *PendingTransaction) Get(input *PendingTransactionInput) (*PendingTransa
 
 // Submit New transactions can be submitted to the blockchain by sending a pending transaction.
 func (t *PendingTransaction) Submit(input *TransactionSubmitInput) error {
+	return t.SubmitWithContext(context.Background(), input)
+}
+
+// SubmitWithContext is Submit with a caller supplied context.
+func (t *PendingTransaction) SubmitWithContext(ctx context.Context, input *TransactionSubmitInput) error {
 	encodedTransaction := base64.StdEncoding.EncodeToString([]byte(input.Transaction))
 	transactionData := TransactionSubmitBody{
 		Txn: encodedTransaction,
@@ -77,9 +104,10 @@ func (t *PendingTransaction) Submit(input *TransactionSubmitInput) error {
 	if err != nil {
 		return err
 	}
-	_, err = t.c.Request(http.MethodPost, "/pending_transactions", bytes.NewBuffer(body), nil)
+	resp, err := t.c.RequestContext(ctx, http.MethodPost, "/pending_transactions", bytes.NewBuffer(body), nil)
 	if err != nil {
 		return err
 	}
+	resp.Body.Close()
 	return nil
 }
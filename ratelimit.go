@@ -0,0 +1,135 @@
+package helium
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by every request made through a Client
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a token that was reserved but not used
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+}
+
+// Wait blocks until a request may be made or ctx is done
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, l.reserve(time.Now())); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// endpointLimiter applies a rateLimiter to paths matching pattern
+type endpointLimiter struct {
+	pattern []string
+	limiter *rateLimiter
+}
+
+// matches reports whether path starts with the pattern, where * matches any single segment
+func (e *endpointLimiter) matches(path []string) bool {
+	if len(e.pattern) > len(path) {
+		return false
+	}
+	for i, segment := range e.pattern {
+		if segment != "*" && segment != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// WithRateLimit client that makes at most rps requests per second with bursts of up to burst requests,
+// shared by every service and goroutine using the client
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(rps, burst)
+	}
+}
+
+// WithEndpointRateLimit limits requests to paths starting with prefix, where * matches a single path segment
+// e.g. /hotspots/*/challenges. When several prefixes match, the longest is used. Endpoint limits apply on top
+// of the limit set by WithRateLimit.
+func WithEndpointRateLimit(prefix string, rps float64, burst int) Option {
+	return func(c *Client) {
+		c.endpointLimiters = append(c.endpointLimiters, &endpointLimiter{
+			pattern: splitPath(prefix),
+			limiter: newRateLimiter(rps, burst),
+		})
+	}
+}
+
+// wait blocks until the rate limits for path allow a request
+func (c *Client) wait(ctx context.Context, path string) error {
+	var match *endpointLimiter
+	if len(c.endpointLimiters) > 0 {
+		segments := splitPath(path)
+		for _, limiter := range c.endpointLimiters {
+			if limiter.matches(segments) && (match == nil || len(limiter.pattern) > len(match.pattern)) {
+				match = limiter
+			}
+		}
+	}
+	if match != nil {
+		if err := match.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			// the endpoint token was never used, give it back
+			if match != nil {
+				match.limiter.cancel()
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package helium
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	var calls int32
+	client := testClient(t, scriptedHandler(&calls), WithRateLimit(100, 1))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Hotspot().Get(&HotspotInput{Address: testAddress})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(50*time.Millisecond))
+	assert.Equal(t, int32(6), calls)
+}
+
+func TestRateLimitCancel(t *testing.T) {
+	limiter := newRateLimiter(0.1, 1)
+	assert.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, limiter.Wait(ctx))
+}
+
+func TestEndpointRateLimit(t *testing.T) {
+	client := ClientWithOptions(
+		WithEndpointRateLimit("/hotspots", 50, 1),
+		WithEndpointRateLimit("/hotspots/*/challenges", 1, 1),
+	)
+	segments := splitPath("/hotspots/11abc/challenges")
+	assert.True(t, client.endpointLimiters[0].matches(segments))
+	assert.True(t, client.endpointLimiters[1].matches(segments))
+	assert.False(t, client.endpointLimiters[1].matches(splitPath("/hotspots/11abc")))
+	assert.False(t, client.endpointLimiters[0].matches(splitPath("/accounts")))
+
+	// the challenges limit is used for challenges, leaving the general hotspot limit untouched
+	ctx := context.Background()
+	assert.NoError(t, client.wait(ctx, "/hotspots/11abc/challenges"))
+	assert.NoError(t, client.wait(ctx, "/hotspots/11abc"))
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, client.wait(ctx, "/hotspots/11def/challenges"))
+}
+
+func TestRateLimitRetry(t *testing.T) {
+	var calls int32
+	client := testClient(t, scriptedHandler(&calls, http.StatusServiceUnavailable),
+		WithRetryPolicy(testRetryPolicy), WithRateLimit(1000, 1))
+	_, err := client.Hotspot().Get(&HotspotInput{Address: testAddress})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), calls)
+}
+
+func TestEndpointRateLimitCancelGlobal(t *testing.T) {
+	client := ClientWithOptions(
+		WithRateLimit(0.1, 1),
+		WithEndpointRateLimit("/hotspots", 0.1, 2),
+	)
+	ctx := context.Background()
+	assert.NoError(t, client.wait(ctx, "/hotspots/11abc"))
+
+	// the global limit is used up, so the endpoint token reserved for this request must be returned
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, client.wait(timeout, "/hotspots/11abc"))
+
+	endpoint, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.NoError(t, client.endpointLimiters[0].limiter.Wait(endpoint))
+}
@@ -0,0 +1,127 @@
+package helium
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy controls how Client.Request retries failed requests
+type RetryPolicy struct {
+	// MaxAttempts caps the total number of attempts, including the first
+	MaxAttempts int
+	// InitialBackoff is the upper bound of the first jittered backoff, it doubles on each attempt
+	InitialBackoff time.Duration
+	// MaxBackoff caps a single backoff, including one requested by Retry-After
+	MaxBackoff time.Duration
+	// MaxElapsed caps the total time spent on a request and its retries, zero means no limit
+	MaxElapsed time.Duration
+	// RetryNonIdempotent allows retrying requests other than GET and HEAD,
+	// such as PendingTransaction.Submit
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy retries up to 5 attempts within 2 minutes
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+	MaxElapsed:     2 * time.Minute,
+}
+
+// WithRetryPolicy client that retries rate limited, unavailable and failed requests. Errors with a
+// Retryable method, such as APIError, are not retried when it returns false.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = &policy
+	}
+}
+
+var (
+	jitterMu sync.Mutex
+	jitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// retries reports whether requests with method may be retried
+func (p *RetryPolicy) retries(method string) bool {
+	if p.RetryNonIdempotent {
+		return true
+	}
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// backoff returns how long to wait before the next attempt after attempt failed with err,
+// and false if the request should not be retried
+func (p *RetryPolicy) backoff(attempt int, start time.Time, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return 0, false
+	}
+
+	// APIErrors and transport errors can tell whether they are worth retrying
+	var retryable interface{ Retryable() bool }
+	if errors.As(err, &retryable) && !retryable.Retryable() {
+		return 0, false
+	}
+	var apiErr *APIError
+	var wait time.Duration
+	if errors.As(err, &apiErr) {
+		wait = apiErr.RetryAfter
+	}
+	if wait <= 0 {
+		ceiling := p.InitialBackoff << uint(attempt-1)
+		if ceiling <= 0 || (p.MaxBackoff > 0 && ceiling > p.MaxBackoff) {
+			ceiling = p.MaxBackoff
+		}
+		if ceiling > 0 {
+			jitterMu.Lock()
+			wait = time.Duration(jitter.Int63n(int64(ceiling)))
+			jitterMu.Unlock()
+		}
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.MaxElapsed > 0 && time.Since(start)+wait > p.MaxElapsed {
+		return 0, false
+	}
+	return wait, true
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an http date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if len(value) == 0 {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,87 @@
+package helium
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// scriptedHandler responds with statuses in order, then 200 with an empty hotspot
+func scriptedHandler(calls *int32, statuses ...int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(calls, 1))
+		if call <= len(statuses) {
+			if statuses[call-1] == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "0")
+			}
+			w.WriteHeader(statuses[call-1])
+			return
+		}
+		w.Write([]byte(`{"data":{"address":"` + testAddress + `"}}`))
+	})
+}
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+	MaxElapsed:     time.Second,
+}
+
+func TestRetrySucceeds(t *testing.T) {
+	var calls int32
+	client := testClient(t, scriptedHandler(&calls, http.StatusServiceUnavailable, http.StatusTooManyRequests), WithRetryPolicy(testRetryPolicy))
+
+	hotspot, err := client.Hotspot().Get(&HotspotInput{Address: testAddress})
+	assert.NoError(t, err)
+	assert.Equal(t, Address(testAddress), hotspot.Data.Address)
+	assert.Equal(t, int32(3), calls)
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	var calls int32
+	statuses := []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway}
+	client := testClient(t, scriptedHandler(&calls, statuses...), WithRetryPolicy(testRetryPolicy))
+
+	_, err := client.Hotspot().Get(&HotspotInput{Address: testAddress})
+	assert.True(t, errors.Is(err, ErrServer))
+	assert.Equal(t, int32(4), calls)
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	var calls int32
+	client := testClient(t, scriptedHandler(&calls, http.StatusNotFound), WithRetryPolicy(testRetryPolicy))
+
+	_, err := client.Hotspot().Get(&HotspotInput{Address: testAddress})
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestRetrySubmit(t *testing.T) {
+	var calls int32
+	client := testClient(t, scriptedHandler(&calls, http.StatusServiceUnavailable), WithRetryPolicy(testRetryPolicy))
+
+	err := client.PendingTransaction().Submit(&TransactionSubmitInput{Transaction: "txn"})
+	assert.True(t, errors.Is(err, ErrServer))
+	assert.Equal(t, int32(1), calls)
+
+	atomic.StoreInt32(&calls, 0)
+	policy := testRetryPolicy
+	policy.RetryNonIdempotent = true
+	client = testClient(t, scriptedHandler(&calls, http.StatusServiceUnavailable), WithRetryPolicy(policy))
+	err = client.PendingTransaction().Submit(&TransactionSubmitInput{Transaction: "txn"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), calls)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, 3*time.Second, parseRetryAfter("3", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter("Sat, 01 May 2021 12:00:30 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("garbage", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+}
@@ -0,0 +1,131 @@
+package helium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type Reward struct {
+	c *Client
+}
+
+func (c *Client) Reward() *Reward {
+	return &Reward{c}
+}
+
+type RewardTransactions struct {
+	Data   Txns   `json:"data"`
+	Cursor string `json:"cursor"`
+}
+
+type RewardStats struct {
+	Data RewardStatsData `json:"data"`
+	Meta Meta            `json:"meta"`
+}
+
+// RewardStatsData is the rewards earned across the network in a time window, Sum and Total are the same
+// amount in bones and HNT and the other fields are statistics over the rewards of every account
+type RewardStatsData struct {
+	Sum    HNT     `json:"sum"`
+	Total  float64 `json:"total"`
+	Avg    float64 `json:"avg"`
+	Max    float64 `json:"max"`
+	Median float64 `json:"median"`
+	Min    float64 `json:"min"`
+	Stddev float64 `json:"stddev"`
+}
+
+type RewardListInput struct {
+	MinTime TimeBound
+	MaxTime TimeBound
+	Cursor  string
+}
+
+type RewardSumInput struct {
+	MinTime TimeBound
+	MaxTime TimeBound
+}
+
+// List Returns the reward transactions of the whole network in a given timeframe.
+func (r *Reward) List(input *RewardListInput) (*RewardTransactions, error) {
+	return r.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (r *Reward) ListWithContext(ctx context.Context, input *RewardListInput) (*RewardTransactions, error) {
+	params := make(map[string]string)
+	params["cursor"] = input.Cursor
+	timeParams(params, input.MinTime, input.MaxTime)
+	resp, err := r.c.RequestContext(ctx, http.MethodGet, "/rewards", new(bytes.Buffer), params)
+	if err != nil {
+		return &RewardTransactions{}, err
+	}
+	defer resp.Body.Close()
+
+	var rewardTransactions *RewardTransactions
+	err = json.NewDecoder(resp.Body).Decode(&rewardTransactions)
+	if err != nil {
+		return &RewardTransactions{}, err
+	}
+	return rewardTransactions, nil
+}
+
+// ListAll iterates over the reward transactions of the whole network in a given timeframe.
+func (r *Reward) ListAll(input *RewardListInput, opts ...PagerOption) *RewardTransactionPager {
+	return NewRewardTransactionPager(func(ctx context.Context, cursor string) (*RewardTransactions, error) {
+		page := *input
+		page.Cursor = cursor
+		return r.ListWithContext(ctx, &page)
+	}, opts...)
+}
+
+// Sum Returns the total rewards of the whole network in a given timeframe with statistics over every account.
+func (r *Reward) Sum(input *RewardSumInput) (*RewardStats, error) {
+	return r.SumWithContext(context.Background(), input)
+}
+
+// SumWithContext is Sum with a caller supplied context.
+func (r *Reward) SumWithContext(ctx context.Context, input *RewardSumInput) (*RewardStats, error) {
+	params := make(map[string]string)
+	timeParams(params, input.MinTime, input.MaxTime)
+	resp, err := r.c.RequestContext(ctx, http.MethodGet, "/rewards/sum", new(bytes.Buffer), params)
+	if err != nil {
+		return &RewardStats{}, err
+	}
+	defer resp.Body.Close()
+
+	var rewardStats *RewardStats
+	err = json.NewDecoder(resp.Body).Decode(&rewardStats)
+	if err != nil {
+		return &RewardStats{}, err
+	}
+	return rewardStats, nil
+}
+
+// SumBuckets Returns the rewards of the whole network in a given timeframe, summed per bucket.
+func (r *Reward) SumBuckets(input *RewardSumInput, bucket Bucket) (*RewardBuckets, error) {
+	return r.SumBucketsWithContext(context.Background(), input, bucket)
+}
+
+// SumBucketsWithContext is SumBuckets with a caller supplied context.
+func (r *Reward) SumBucketsWithContext(ctx context.Context, input *RewardSumInput, bucket Bucket) (*RewardBuckets, error) {
+	params := make(map[string]string)
+	err := bucketParams(params, input.MinTime, input.MaxTime, bucket)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	resp, err := r.c.RequestContext(ctx, http.MethodGet, "/rewards/sum", new(bytes.Buffer), params)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	defer resp.Body.Close()
+
+	var rewardBuckets *RewardBuckets
+	err = json.NewDecoder(resp.Body).Decode(&rewardBuckets)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	return rewardBuckets, nil
+}
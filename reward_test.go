@@ -0,0 +1,80 @@
+package helium
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewardList(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rewards", r.URL.Path)
+		assert.Equal(t, "-1 day", r.URL.Query().Get("min_time"))
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"data":[{"type":"rewards_v2","hash":"r1","height":10,"start_epoch":1,"end_epoch":9,` +
+				`"rewards":[{"type":"poc_witnesses","account":"` + testAccount + `","gateway":"` + testAddress + `","amount":125}]}],"cursor":"next"}`))
+		case "next":
+			w.Write([]byte(`{"data":[{"type":"rewards_v2","hash":"r2","height":20,"rewards":[]}]}`))
+		}
+	}))
+
+	pager := client.Reward().ListAll(&RewardListInput{MinTime: Ago(24 * time.Hour)})
+	var hashes []string
+	for pager.Next(context.Background()) {
+		hashes = append(hashes, pager.Item().TxnHash())
+	}
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []string{"r1", "r2"}, hashes)
+
+	rewards, err := client.Reward().List(&RewardListInput{MinTime: Ago(24 * time.Hour)})
+	assert.NoError(t, err)
+	txn := rewards.Data[0].(*RewardsV2)
+	assert.Equal(t, HNT(125), txn.Rewards[0].Amount)
+	assert.Equal(t, Address(testAccount), txn.Rewards[0].Account)
+}
+
+func TestRewardSum(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rewards/sum", r.URL.Path)
+		if r.URL.Query().Get("bucket") == "week" {
+			w.Write([]byte(`{"data":[{"timestamp":"2021-06-01T00:00:00.000000Z","sum":250000000,"total":2.5,"avg":0.5}],"meta":{"bucket":"week"}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"sum":250000000,"total":2.5,"avg":0.5,"max":1.25,"median":0.4,"min":0,"stddev":0.3},` +
+			`"meta":{"min_time":"2021-05-01T00:00:00Z","max_time":"2021-06-01T00:00:00Z"}}`))
+	}))
+
+	sum, err := client.Reward().Sum(&RewardSumInput{MinTime: "2021-05-01", MaxTime: "2021-06-01"})
+	assert.NoError(t, err)
+	assert.Equal(t, HNT(250000000), sum.Data.Sum)
+	assert.Equal(t, 0.5, sum.Data.Avg)
+	assert.Equal(t, 1.25, sum.Data.Max)
+	assert.Equal(t, 2021, sum.Meta.MaxTime.Year())
+
+	buckets, err := client.Reward().SumBuckets(&RewardSumInput{MinTime: Ago(28 * 24 * time.Hour)}, BucketWeek)
+	assert.NoError(t, err)
+	assert.Equal(t, BucketWeek, buckets.Meta.Bucket)
+	assert.Equal(t, 2.5, buckets.Data[0].Total)
+}
+
+func TestAccountRewards(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/"+testAccount+"/rewards", r.URL.Path)
+		w.Write([]byte(`{"data":[{"account":"` + testAccount + `","amount":125,"block":10,"gateway":"` + testAddress + `",` +
+			`"hash":"r1","timestamp":"2021-06-01T00:00:00.000000Z"}]}`))
+	}))
+
+	rewards, err := client.Account().Rewards(&AccountRewardsInput{ID: testAccount})
+	assert.NoError(t, err)
+	reward := rewards.Data[0]
+	assert.Equal(t, HNT(125), reward.Amount)
+	assert.Equal(t, Address(testAddress), reward.Gateway)
+	assert.Equal(t, Address(testAccount), reward.Account)
+	assert.Equal(t, 10, reward.Block)
+	assert.Equal(t, "r1", reward.Hash)
+	assert.Equal(t, 2021, reward.Timestamp.Year())
+}
@@ -0,0 +1,35 @@
+package helium
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Roles is a page of the transactions an account, hotspot or validator took part in and the role it had in each
+type Roles struct {
+	Data   []RoleData `json:"data"`
+	Cursor string     `json:"cursor"`
+}
+
+type RoleData struct {
+	Type   TxnType   `json:"type"`
+	Time   Timestamp `json:"time"`
+	Role   string    `json:"role"`
+	Height int       `json:"height"`
+	Hash   string    `json:"hash"`
+}
+
+// activityParams adds the filters of an activity or roles query that are set to params
+func activityParams(params map[string]string, filterTypes []TxnType, minTime, maxTime TimeBound, limit int) {
+	if len(filterTypes) > 0 {
+		types := make([]string, len(filterTypes))
+		for i, t := range filterTypes {
+			types[i] = string(t)
+		}
+		params["filter_types"] = strings.Join(types, ",")
+	}
+	timeParams(params, minTime, maxTime)
+	if limit > 0 {
+		params["limit"] = strconv.Itoa(limit)
+	}
+}
@@ -0,0 +1,42 @@
+package helium
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHotspotRoles(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/hotspots/"+testAddress+"/roles", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "poc_receipts_v1,rewards_v2", query.Get("filter_types"))
+		assert.Equal(t, "-7 day", query.Get("min_time"))
+		assert.Equal(t, "", query.Get("max_time"))
+		assert.Equal(t, "50", query.Get("limit"))
+		w.Write([]byte(`{"data":[{"type":"poc_receipts_v1","time":1622505600,"role":"witness","height":10,"hash":"r1"}],"cursor":"next"}`))
+	}))
+
+	roles, err := client.Hotspot().Roles(&HotspotActivityInput{
+		Address:     testAddress,
+		FilterTypes: []TxnType{TxnPocReceiptsV1, TxnRewardsV2},
+		MinTime:     Ago(7 * 24 * time.Hour),
+		Limit:       50,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "next", roles.Cursor)
+	assert.Equal(t, RoleData{Type: TxnPocReceiptsV1, Time: Unix(1622505600), Role: "witness", Height: 10, Hash: "r1"}, roles.Data[0])
+}
+
+func TestValidatorActivityCount(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/validators/"+testAddress+"/activity/count", r.URL.Path)
+		assert.Equal(t, "validator_heartbeat_v1", r.URL.Query().Get("filter_types"))
+		w.Write([]byte(`{"data":{"validator_heartbeat_v1":3}}`))
+	}))
+
+	_, err := client.Validator().ActivityCount(testAddress, []TxnType{TxnValidatorHeartbeatV1})
+	assert.NoError(t, err)
+}
@@ -2,8 +2,9 @@ package helium
 
 import (
 	"bytes"
-	"net/http"
+	"context"
 	"encoding/json"
+	"net/http"
 )
 
 // Stat handles api endpoint /stats docs located at https://docs.helium.com/api/blockchain/stats
@@ -84,17 +85,22 @@ type TokenSupplyData struct {
 	TokenSupply float64 `json:"token_supply"`
 }
 
-/* 
-List Retrieve basic stats for the blockchain such as total token supply, 
+/*
+List Retrieve basic stats for the blockchain such as total token supply,
 and average block and election times over a number of intervals.
 */
 func (s *Stat) List() (*Stats, error) {
-	resp, err := s.c.Request(http.MethodGet, "/stats", new(bytes.Buffer), nil)
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is List with a caller supplied context.
+func (s *Stat) ListWithContext(ctx context.Context) (*Stats, error) {
+	resp, err := s.c.RequestContext(ctx, http.MethodGet, "/stats", new(bytes.Buffer), nil)
 	if err != nil {
 		return &Stats{}, err
 	}
 	defer resp.Body.Close()
-	
+
 	var stats *Stats
 	err = json.NewDecoder(resp.Body).Decode(&stats)
 	if err != nil {
@@ -105,7 +111,12 @@ func (s *Stat) List() (*Stats, error) {
 
 // TokenSupply Returns the circulating token supply
 func (s *Stat) TokenSupply() (*TokenSupply, error) {
-	resp, err := s.c.Request(http.MethodGet, "/stats/token_supply", new(bytes.Buffer), nil)
+	return s.TokenSupplyWithContext(context.Background())
+}
+
+// TokenSupplyWithContext is TokenSupply with a caller supplied context.
+func (s *Stat) TokenSupplyWithContext(ctx context.Context) (*TokenSupply, error) {
+	resp, err := s.c.RequestContext(ctx, http.MethodGet, "/stats/token_supply", new(bytes.Buffer), nil)
 	if err != nil {
 		return &TokenSupply{}, err
 	}
@@ -117,4 +128,4 @@ func (s *Stat) TokenSupply() (*TokenSupply, error) {
 		return &TokenSupply{}, err
 	}
 	return tokenSupply, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,186 @@
+package helium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// StateChannel handles the state channels routers buy packets from hotspots through, at /state_channels
+type StateChannel struct {
+	c *Client
+}
+
+// StateChannel returns the StateChannel client
+func (c *Client) StateChannel() *StateChannel {
+	return &StateChannel{c}
+}
+
+type StateChannels struct {
+	Data   []StateChannelCloseV1 `json:"data"`
+	Cursor string                `json:"cursor"`
+}
+
+type StateChannelStats struct {
+	Data StateChannelStatsData `json:"data"`
+}
+
+type StateChannelStatsData struct {
+	LastDay   StateChannelTraffic `json:"last_day"`
+	LastWeek  StateChannelTraffic `json:"last_week"`
+	LastMonth StateChannelTraffic `json:"last_month"`
+}
+
+// StateChannelTraffic is the packets and data credits exchanged through state channels
+type StateChannelTraffic struct {
+	NumPackets int `json:"num_packets"`
+	NumDcs     DC  `json:"num_dcs"`
+}
+
+// HotspotTraffic is the data traffic a hotspot carried through the state channels closed in a time window,
+// MinTime and MaxTime are the bounds of the window resolved to absolute times
+type HotspotTraffic struct {
+	StateChannelTraffic
+	Address Address
+	MinTime time.Time
+	MaxTime time.Time
+	// StateChannels is the number of closed state channels with a summary for the hotspot
+	StateChannels int
+}
+
+type StateChannelListInput struct {
+	Cursor string
+}
+
+// HotspotTrafficInput is the hotspot and time window to sum traffic for. MinTime is required, as state
+// channels are paged from the newest until it is reached, an empty MaxTime is now. The window is cut
+// client side, so the bounds are resolved against the local clock and only the forms At and Ago produce,
+// dates and relative bounds in weeks, months or years are accepted.
+type HotspotTrafficInput struct {
+	Address Address
+	MinTime TimeBound
+	MaxTime TimeBound
+}
+
+// Summary returns the summary of the traffic a hotspot sent through the state channel
+func (s *StateChannelCloseV1) Summary(address Address) (StateChannelSummary, bool) {
+	for _, summary := range s.StateChannel.Summaries {
+		if summary.Client == address {
+			return summary, true
+		}
+	}
+	return StateChannelSummary{}, false
+}
+
+// Traffic returns the total traffic of every hotspot through the state channel
+func (s *StateChannelCloseV1) Traffic() StateChannelTraffic {
+	var traffic StateChannelTraffic
+	for _, summary := range s.StateChannel.Summaries {
+		traffic.NumPackets += summary.NumPackets
+		traffic.NumDcs += summary.NumDcs
+	}
+	return traffic
+}
+
+// List Lists the state channel close transactions, newest first.
+func (s *StateChannel) List(input *StateChannelListInput) (*StateChannels, error) {
+	return s.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (s *StateChannel) ListWithContext(ctx context.Context, input *StateChannelListInput) (*StateChannels, error) {
+	params := make(map[string]string)
+	params["cursor"] = input.Cursor
+	resp, err := s.c.RequestContext(ctx, http.MethodGet, "/state_channels", new(bytes.Buffer), params)
+	if err != nil {
+		return &StateChannels{}, err
+	}
+	defer resp.Body.Close()
+
+	var stateChannels *StateChannels
+	err = json.NewDecoder(resp.Body).Decode(&stateChannels)
+	if err != nil {
+		return &StateChannels{}, err
+	}
+	return stateChannels, nil
+}
+
+// ListAll iterates over every state channel close transaction, newest first.
+func (s *StateChannel) ListAll(opts ...PagerOption) *StateChannelPager {
+	return NewStateChannelPager(func(ctx context.Context, cursor string) (*StateChannels, error) {
+		return s.ListWithContext(ctx, &StateChannelListInput{Cursor: cursor})
+	}, opts...)
+}
+
+// Stats Returns the packets and data credits exchanged through state channels over the last day, week and month.
+func (s *StateChannel) Stats() (*StateChannelStats, error) {
+	return s.StatsWithContext(context.Background())
+}
+
+// StatsWithContext is Stats with a caller supplied context.
+func (s *StateChannel) StatsWithContext(ctx context.Context) (*StateChannelStats, error) {
+	resp, err := s.c.RequestContext(ctx, http.MethodGet, "/state_channels/stats", new(bytes.Buffer), nil)
+	if err != nil {
+		return &StateChannelStats{}, err
+	}
+	defer resp.Body.Close()
+
+	var stateChannelStats *StateChannelStats
+	err = json.NewDecoder(resp.Body).Decode(&stateChannelStats)
+	if err != nil {
+		return &StateChannelStats{}, err
+	}
+	return stateChannelStats, nil
+}
+
+// HotspotTraffic Sums the traffic a hotspot carried through the state channels closed in a time window.
+// It pages through close transactions, newest first, until it reaches the start of the window.
+func (s *StateChannel) HotspotTraffic(input *HotspotTrafficInput) (*HotspotTraffic, error) {
+	return s.HotspotTrafficWithContext(context.Background(), input)
+}
+
+// HotspotTrafficWithContext is HotspotTraffic with a caller supplied context.
+func (s *StateChannel) HotspotTrafficWithContext(ctx context.Context, input *HotspotTrafficInput) (*HotspotTraffic, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &HotspotTraffic{}, err
+	}
+	if input.MinTime == "" {
+		return &HotspotTraffic{}, errors.New("helium: HotspotTraffic requires a MinTime")
+	}
+	now := time.Now()
+	minTime, err := input.MinTime.resolve(now)
+	if err != nil {
+		return &HotspotTraffic{}, err
+	}
+	maxTime, err := input.MaxTime.resolve(now)
+	if err != nil {
+		return &HotspotTraffic{}, err
+	}
+	traffic := &HotspotTraffic{Address: input.Address, MinTime: minTime, MaxTime: maxTime}
+	pager := s.ListAll()
+	for pager.Next(ctx) {
+		closed := pager.Item()
+		at := closed.TxnTime()
+		if at.Before(minTime) {
+			break
+		}
+		if !maxTime.IsZero() && !at.Before(maxTime) {
+			continue
+		}
+		summary, ok := closed.Summary(input.Address)
+		if !ok {
+			continue
+		}
+		traffic.NumPackets += summary.NumPackets
+		traffic.NumDcs += summary.NumDcs
+		traffic.StateChannels++
+	}
+	if pager.Err() != nil {
+		return &HotspotTraffic{}, pager.Err()
+	}
+	return traffic, nil
+}
@@ -0,0 +1,77 @@
+package helium
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateChannelList(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/state_channels", r.URL.Path)
+		w.Write([]byte(`{"data":[{"type":"state_channel_close_v1","hash":"sc1","height":10,"time":1622505600,"closer":"` + testAccount + `",` +
+			`"state_channel":{"id":"c1","owner":"` + testAccount + `","nonce":4,"state":"closed","summaries":[` +
+			`{"client":"` + testAddress + `","num_packets":12,"num_dcs":15},{"client":"` + testAccount + `","num_packets":1,"num_dcs":1}]}}]}`))
+	}))
+
+	channels, err := client.StateChannel().List(&StateChannelListInput{})
+	assert.NoError(t, err)
+	closed := channels.Data[0]
+	assert.Equal(t, int64(1622505600), closed.TxnTime().Unix())
+	summary, ok := closed.Summary(testAddress)
+	assert.True(t, ok)
+	assert.Equal(t, 12, summary.NumPackets)
+	assert.Equal(t, StateChannelTraffic{NumPackets: 13, NumDcs: 16}, closed.Traffic())
+	_, ok = closed.Summary("")
+	assert.False(t, ok)
+}
+
+func TestStateChannelHotspotTraffic(t *testing.T) {
+	// five pages of one state channel each, an hour apart, newest first
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	var requests int32
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/state_channels", r.URL.Path)
+		page := int(atomic.AddInt32(&requests, 1)) - 1
+		cursor := ""
+		if page < 4 {
+			cursor = fmt.Sprintf(`,"cursor":"page-%d"`, page+1)
+		}
+		w.Write([]byte(fmt.Sprintf(`{"data":[{"type":"state_channel_close_v1","hash":"sc%d","time":%d,"state_channel":{"summaries":[`+
+			`{"client":"%s","num_packets":%d,"num_dcs":%d}]}}]%s}`,
+			page, start.Add(-time.Duration(page)*time.Hour).Unix(), testAddress, page+1, 2*(page+1), cursor)))
+	}))
+
+	// the window covers the channels closed at start-1h and start-2h, the one at start is excluded by MaxTime
+	traffic, err := client.StateChannel().HotspotTraffic(&HotspotTrafficInput{
+		Address: testAddress,
+		MinTime: At(start.Add(-150 * time.Minute)),
+		MaxTime: At(start),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, traffic.StateChannels)
+	assert.Equal(t, 2+3, traffic.NumPackets)
+	assert.Equal(t, DC(4+6), traffic.NumDcs)
+	// the fourth page is past MinTime, the fifth is never fetched
+	assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+
+	assert.Equal(t, start.Add(-150*time.Minute), traffic.MinTime)
+	assert.Equal(t, start, traffic.MaxTime)
+
+	_, err = client.StateChannel().HotspotTraffic(&HotspotTrafficInput{Address: testAddress})
+	assert.Error(t, err)
+	_, err = client.StateChannel().HotspotTraffic(&HotspotTrafficInput{Address: testAddress, MinTime: "yesterday"})
+	assert.Error(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+
+	// relative bounds are resolved against the local clock, every channel is older than a day ago
+	traffic, err = client.StateChannel().HotspotTraffic(&HotspotTrafficInput{Address: testAddress, MinTime: Ago(24 * time.Hour)})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, traffic.StateChannels)
+	assert.WithinDuration(t, time.Now().Add(-24*time.Hour), traffic.MinTime, time.Minute)
+	assert.Equal(t, int32(5), atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,116 @@
+package helium
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timestamp is a point in time as the api returns it, unix seconds, unix nanoseconds or an ISO8601 string
+// depending on the endpoint. It embeds the decoded time.Time and encodes back to the form it was decoded from,
+// a Timestamp built in code encodes as an RFC3339 string.
+type Timestamp struct {
+	time.Time
+
+	// unit is the unit of a numeric timestamp, zero for a string
+	unit time.Duration
+	// digits is the number of fractional second digits of a string timestamp
+	digits int
+}
+
+// Unix returns the Timestamp of sec seconds since the unix epoch, it encodes as a number of seconds
+func Unix(sec int64) Timestamp {
+	return Timestamp{Time: time.Unix(sec, 0).UTC(), unit: time.Second}
+}
+
+// UnixNano returns the Timestamp of nsec nanoseconds since the unix epoch, it encodes as a number of nanoseconds
+func UnixNano(nsec int64) Timestamp {
+	return Timestamp{Time: time.Unix(0, nsec).UTC(), unit: time.Nanosecond}
+}
+
+// UnmarshalJSON decodes a number of seconds, milliseconds, microseconds or nanoseconds since the unix epoch,
+// telling them apart by magnitude, or an ISO8601 string. null leaves the Timestamp zero.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return err
+		}
+		if s == "" {
+			*t = Timestamp{}
+			return nil
+		}
+		parsed, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return fmt.Errorf("helium: invalid timestamp %s: %w", data, err)
+		}
+		*t = Timestamp{Time: parsed, digits: fractionDigits(s)}
+		return nil
+	}
+	n, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("helium: invalid timestamp %s", data)
+	}
+	*t = timestampFromInt(n)
+	return nil
+}
+
+// MarshalJSON encodes the Timestamp in the form it was decoded from, a zero Timestamp encodes as null
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	switch {
+	case t.unit == time.Second:
+		return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+	case t.unit > 0:
+		return []byte(strconv.FormatInt(t.UnixNano()/int64(t.unit), 10)), nil
+	case t.IsZero():
+		return []byte("null"), nil
+	}
+	layout := time.RFC3339Nano
+	if t.digits > 0 {
+		layout = "2006-01-02T15:04:05." + strings.Repeat("0", t.digits) + "Z07:00"
+	}
+	return json.Marshal(t.Format(layout))
+}
+
+// timestampFromInt guesses the unit of n from its magnitude, seconds stay below 1e11 until the year 5138
+func timestampFromInt(n int64) Timestamp {
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs < 1e11:
+		return Unix(n)
+	case abs < 1e14:
+		return Timestamp{Time: time.Unix(0, n*int64(time.Millisecond)).UTC(), unit: time.Millisecond}
+	case abs < 1e17:
+		return Timestamp{Time: time.Unix(0, n*int64(time.Microsecond)).UTC(), unit: time.Microsecond}
+	default:
+		return UnixNano(n)
+	}
+}
+
+// fractionDigits counts the fractional second digits of an RFC3339 string
+func fractionDigits(s string) int {
+	i := strings.IndexByte(s, '.')
+	if i < 0 {
+		return 0
+	}
+	n := 0
+	for _, c := range s[i+1:] {
+		if c < '0' || c > '9' {
+			break
+		}
+		n++
+	}
+	return n
+}
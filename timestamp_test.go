@@ -0,0 +1,61 @@
+package helium
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestampRoundTrip(t *testing.T) {
+	want := time.Date(2021, 6, 1, 12, 30, 0, 0, time.UTC)
+	for encoded, at := range map[string]time.Time{
+		`1622550600`:                    want,
+		`1622550600000`:                 want,
+		`1622550600000000`:              want,
+		`1622550600123456789`:           want.Add(123456789),
+		`"2021-06-01T12:30:00Z"`:        want,
+		`"2021-06-01T12:30:00.000000Z"`: want,
+		`"2021-06-01T12:30:00.25Z"`:     want.Add(250 * time.Millisecond),
+	} {
+		var ts Timestamp
+		assert.NoError(t, json.Unmarshal([]byte(encoded), &ts), encoded)
+		assert.True(t, at.Equal(ts.Time), encoded)
+		out, err := json.Marshal(ts)
+		assert.NoError(t, err)
+		assert.Equal(t, encoded, string(out))
+	}
+
+	var ts Timestamp
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &ts))
+	assert.True(t, ts.IsZero())
+	out, err := json.Marshal(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(out))
+
+	out, err = json.Marshal(Timestamp{Time: want})
+	assert.NoError(t, err)
+	assert.Equal(t, `"2021-06-01T12:30:00Z"`, string(out))
+
+	assert.Error(t, json.Unmarshal([]byte(`"yesterday"`), &ts))
+	assert.Error(t, json.Unmarshal([]byte(`1.5`), &ts))
+}
+
+func TestTimestampAcrossModels(t *testing.T) {
+	var block BlockData
+	assert.NoError(t, json.Unmarshal([]byte(`{"height":1,"time":1622550600}`), &block))
+	var witness Witness
+	assert.NoError(t, json.Unmarshal([]byte(`{"timestamp":1622550660000000000}`), &witness))
+	var pending PendingTransactionData
+	assert.NoError(t, json.Unmarshal([]byte(`{"created_at":"2021-06-01T12:29:00.000000Z"}`), &pending))
+
+	times := []Timestamp{witness.Timestamp, block.Time, pending.CreatedAt}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j].Time) })
+	assert.Equal(t, []Timestamp{pending.CreatedAt, block.Time, witness.Timestamp}, times)
+
+	txn, err := DecodeTxn([]byte(`{"type":"payment_v1","hash":"h","height":1,"time":1622550600}`))
+	assert.NoError(t, err)
+	assert.True(t, block.Time.Equal(txn.TxnTime()))
+}
@@ -0,0 +1,210 @@
+package helium
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// BonesPerHNT is the number of bones, the smallest unit, in one HNT or HST
+const BonesPerHNT = 100000000
+
+// dcPerUSD is the fixed price of data credits, one DC is $0.00001
+const dcPerUSD = 100000
+
+// oraclePriceScale is the scale of oracle prices, which are in 1e-8 USD per HNT
+const oraclePriceScale = 100000000
+
+// ErrInvalidAmount is returned when parsing or decoding a malformed token amount
+var ErrInvalidAmount = errors.New("helium: invalid amount")
+
+// HNT is an amount of HNT in bones, as it is reported by the api
+//
+//	five := 5 * helium.HNT(helium.BonesPerHNT)
+//	fmt.Println(five + account.Data.Balance)
+type HNT int64
+
+// ParseHNT parses a decimal HNT amount such as "1.5", with at most eight decimal places
+func ParseHNT(s string) (HNT, error) {
+	v, err := parseDecimal(s, 8)
+	return HNT(v), err
+}
+
+// String formats the amount in HNT, e.g. 150000000 bones is "1.5"
+func (h HNT) String() string {
+	return formatDecimal(int64(h), 8)
+}
+
+// Float64 returns the amount in HNT, which may lose precision
+func (h HNT) Float64() float64 {
+	return float64(h) / BonesPerHNT
+}
+
+// USD converts the amount to US dollars at price, an oracle price in 1e-8 USD per HNT as in OraclePriceData.Price
+func (h HNT) USD(price int) float64 {
+	usd := new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(int64(h)), big.NewInt(int64(price))),
+		new(big.Int).Mul(big.NewInt(BonesPerHNT), big.NewInt(oraclePriceScale)),
+	)
+	f, _ := usd.Float64()
+	return f
+}
+
+// DC returns the data credits received for burning the amount at price, an oracle price in 1e-8 USD per HNT
+func (h HNT) DC(price int) DC {
+	dc := new(big.Int).Mul(big.NewInt(int64(h)), big.NewInt(int64(price)))
+	dc.Mul(dc, big.NewInt(dcPerUSD))
+	dc.Quo(dc, new(big.Int).Mul(big.NewInt(BonesPerHNT), big.NewInt(oraclePriceScale)))
+	return DC(dc.Int64())
+}
+
+// UnmarshalJSON decodes bones given as a JSON number or string
+func (h *HNT) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalAmount(data)
+	*h = HNT(v)
+	return err
+}
+
+// DC is an amount of data credits, one DC is fixed at $0.00001
+type DC int64
+
+// ParseDC parses a whole number of data credits
+func ParseDC(s string) (DC, error) {
+	v, err := parseDecimal(s, 0)
+	return DC(v), err
+}
+
+// String formats the number of data credits
+func (d DC) String() string {
+	return formatDecimal(int64(d), 0)
+}
+
+// USD returns the value of the data credits in US dollars
+func (d DC) USD() float64 {
+	return float64(d) / dcPerUSD
+}
+
+// HNT returns the amount burned for the data credits at price, an oracle price in 1e-8 USD per HNT,
+// it is zero for a zero price
+func (d DC) HNT(price int) HNT {
+	if price <= 0 {
+		return 0
+	}
+	bones := new(big.Int).Mul(big.NewInt(int64(d)), big.NewInt(BonesPerHNT))
+	bones.Mul(bones, big.NewInt(oraclePriceScale))
+	bones.Quo(bones, new(big.Int).Mul(big.NewInt(int64(price)), big.NewInt(dcPerUSD)))
+	return HNT(bones.Int64())
+}
+
+// UnmarshalJSON decodes data credits given as a JSON number or string
+func (d *DC) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalAmount(data)
+	*d = DC(v)
+	return err
+}
+
+// HST is an amount of Helium security tokens in bones
+type HST int64
+
+// ParseHST parses a decimal HST amount such as "0.25", with at most eight decimal places
+func ParseHST(s string) (HST, error) {
+	v, err := parseDecimal(s, 8)
+	return HST(v), err
+}
+
+// String formats the amount in HST
+func (h HST) String() string {
+	return formatDecimal(int64(h), 8)
+}
+
+// UnmarshalJSON decodes bones given as a JSON number or string
+func (h *HST) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalAmount(data)
+	*h = HST(v)
+	return err
+}
+
+// unmarshalAmount decodes a whole number of base units from a JSON number or string, null is zero
+func unmarshalAmount(data []byte) (int64, error) {
+	s := strings.TrimSpace(string(data))
+	if s == "null" {
+		return 0, nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return 0, err
+		}
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return v, nil
+	}
+	// large amounts are sometimes written in exponent form
+	f, ok := new(big.Float).SetString(s)
+	if !ok || !f.IsInt() {
+		return 0, fmt.Errorf("%w %s", ErrInvalidAmount, data)
+	}
+	i, accuracy := f.Int64()
+	if accuracy != big.Exact {
+		return 0, fmt.Errorf("%w %s", ErrInvalidAmount, data)
+	}
+	return i, nil
+}
+
+// parseDecimal parses s as a fixed point number with at most decimals places, returning it in base units
+func parseDecimal(s string, decimals int) (int64, error) {
+	invalid := fmt.Errorf("%w %q", ErrInvalidAmount, s)
+	negative := strings.HasPrefix(s, "-")
+	digits := s
+	if negative || strings.HasPrefix(s, "+") {
+		digits = s[1:]
+	}
+	whole, frac := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		whole, frac = digits[:i], digits[i+1:]
+	}
+	if whole == "" && frac == "" || len(frac) > decimals || decimals == 0 && strings.Contains(digits, ".") {
+		return 0, invalid
+	}
+	var v uint64
+	for _, c := range whole + frac + strings.Repeat("0", decimals-len(frac)) {
+		if c < '0' || c > '9' {
+			return 0, invalid
+		}
+		if v > (math.MaxInt64-uint64(c-'0'))/10 {
+			return 0, invalid
+		}
+		v = v*10 + uint64(c-'0')
+	}
+	if negative {
+		return -int64(v), nil
+	}
+	return int64(v), nil
+}
+
+// formatDecimal formats v base units as a fixed point number with decimals places, trimming trailing zeros
+func formatDecimal(v int64, decimals int) string {
+	sign := ""
+	u := uint64(v)
+	if v < 0 {
+		sign = "-"
+		u = uint64(-v)
+	}
+	s := strconv.FormatUint(u, 10)
+	if decimals == 0 {
+		return sign + s
+	}
+	if len(s) <= decimals {
+		s = strings.Repeat("0", decimals-len(s)+1) + s
+	}
+	whole, frac := s[:len(s)-decimals], strings.TrimRight(s[len(s)-decimals:], "0")
+	if frac == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + frac
+}
@@ -0,0 +1,90 @@
+package helium
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHNTFormat(t *testing.T) {
+	for s, bones := range map[string]HNT{
+		"0":                    0,
+		"1":                    BonesPerHNT,
+		"1.5":                  150000000,
+		"0.00000001":           1,
+		"-2.25":                -225000000,
+		"92233720368.54775807": 9223372036854775807,
+	} {
+		assert.Equal(t, s, bones.String())
+		parsed, err := ParseHNT(s)
+		assert.NoError(t, err)
+		assert.Equal(t, bones, parsed)
+	}
+
+	parsed, err := ParseHNT("12.")
+	assert.NoError(t, err)
+	assert.Equal(t, HNT(12*BonesPerHNT), parsed)
+	parsed, err = ParseHNT(".5")
+	assert.NoError(t, err)
+	assert.Equal(t, HNT(50000000), parsed)
+
+	for _, invalid := range []string{"", ".", "1.000000001", "1e8", "1,5", "92233720368.54775808", "--1", "-+1", "+-1"} {
+		_, err := ParseHNT(invalid)
+		assert.True(t, errors.Is(err, ErrInvalidAmount), invalid)
+	}
+	_, err = ParseDC("+-1")
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+	parsed, err = ParseHNT("+1")
+	assert.NoError(t, err)
+	assert.Equal(t, HNT(BonesPerHNT), parsed)
+}
+
+func TestDC(t *testing.T) {
+	dc, err := ParseDC("35000")
+	assert.NoError(t, err)
+	assert.Equal(t, DC(35000), dc)
+	assert.Equal(t, "35000", dc.String())
+	assert.Equal(t, 0.35, dc.USD())
+	_, err = ParseDC("1.5")
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	hst, err := ParseHST("0.25")
+	assert.NoError(t, err)
+	assert.Equal(t, HST(25000000), hst)
+}
+
+func TestHNTConversion(t *testing.T) {
+	// $15.00 per HNT
+	price := 1500000000
+	assert.Equal(t, 15.0, HNT(BonesPerHNT).USD(price))
+	assert.Equal(t, 3.75, HNT(25000000).USD(price))
+	assert.Equal(t, DC(1500000), HNT(BonesPerHNT).DC(price))
+	assert.Equal(t, 1.5e9, HNT(100000*BonesPerHNT).USD(price*1000))
+	assert.Equal(t, HNT(BonesPerHNT), DC(1500000).HNT(price))
+	assert.Equal(t, HNT(0), DC(1500000).HNT(0))
+}
+
+func TestAmountJSON(t *testing.T) {
+	var account AccountData
+	err := json.Unmarshal([]byte(`{"balance":250000000000,"dc_balance":"1000000","sec_balance":null}`), &account)
+	assert.NoError(t, err)
+	assert.Equal(t, HNT(250000000000), account.Balance)
+	assert.Equal(t, "2500", account.Balance.String())
+	assert.Equal(t, DC(1000000), account.DcBalance)
+	assert.Equal(t, HST(0), account.SecBalance)
+
+	var sum RewardSumData
+	assert.NoError(t, json.Unmarshal([]byte(`{"sum":"1234567890"}`), &sum))
+	assert.Equal(t, HNT(1234567890), sum.Sum)
+	assert.NoError(t, json.Unmarshal([]byte(`{"sum":1.2e+10}`), &sum))
+	assert.Equal(t, HNT(12000000000), sum.Sum)
+
+	err = json.Unmarshal([]byte(`{"sum":"1.5"}`), &sum)
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	b, err := json.Marshal(Payments{Payee: testAccount, Amount: 5 * BonesPerHNT})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"payee":"`+testAccount+`","amount":500000000}`, string(b))
+}
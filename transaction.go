@@ -2,9 +2,10 @@ package helium
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
-	"encoding/json"
 )
 
 type Transaction struct {
@@ -16,12 +17,33 @@ func (c *Client) Transaction() *Transaction {
 }
 
 type TransactionInfo struct {
-	Data TransactionData `json:"data"`
+	Data Txn `json:"data"`
+}
+
+// UnmarshalJSON decodes Data into the concrete type named by its type field
+func (t *TransactionInfo) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Data json.RawMessage `json:"data"`
+	}
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+	if len(aux.Data) == 0 || string(aux.Data) == "null" {
+		return nil
+	}
+	t.Data, err = DecodeTxn(aux.Data)
+	return err
 }
 
 // Get Fetch the transaction for a given hash.
 func (t *Transaction) Get(hash string) (*TransactionInfo, error) {
-	resp, err := t.c.Request(http.MethodGet, fmt.Sprintf("/transactions/%s", hash), new(bytes.Buffer), nil)
+	return t.GetWithContext(context.Background(), hash)
+}
+
+// GetWithContext is Get with a caller supplied context.
+func (t *Transaction) GetWithContext(ctx context.Context, hash string) (*TransactionInfo, error) {
+	resp, err := t.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/transactions/%s", hash), new(bytes.Buffer), nil)
 	if err != nil {
 		return &TransactionInfo{}, err
 	}
@@ -33,4 +55,4 @@ func (t *Transaction) Get(hash string) (*TransactionInfo, error) {
 		return &TransactionInfo{}, err
 	}
 	return transactionInfo, nil
-}
\ No newline at end of file
+}
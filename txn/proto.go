@@ -0,0 +1,71 @@
+package txn
+
+import "fmt"
+
+// Protobuf wire types
+const (
+	wireVarint = 0
+	wireBytes  = 2
+)
+
+// encoder appends fields in the protobuf wire format. Fields must be written in field number
+// order and, as in proto3, scalar fields holding their zero value are left out.
+type encoder struct {
+	buf []byte
+	err error
+}
+
+func (e *encoder) varint(v uint64) {
+	for v >= 0x80 {
+		e.buf = append(e.buf, byte(v)|0x80)
+		v >>= 7
+	}
+	e.buf = append(e.buf, byte(v))
+}
+
+func (e *encoder) tag(field, wire int) {
+	e.varint(uint64(field)<<3 | uint64(wire))
+}
+
+func (e *encoder) uint64(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+	e.tag(field, wireVarint)
+	e.varint(v)
+}
+
+// amount encodes a token amount as a uint64, amounts can not be negative
+func (e *encoder) amount(field int, name string, v int64) {
+	if v < 0 && e.err == nil {
+		e.err = fmt.Errorf("txn: %s can not be negative", name)
+	}
+	e.uint64(field, uint64(v))
+}
+
+// int32 encodes v as a protobuf int32, negative values are sign extended to ten bytes
+func (e *encoder) int32(field int, v int32) {
+	if v == 0 {
+		return
+	}
+	e.tag(field, wireVarint)
+	e.varint(uint64(int64(v)))
+}
+
+func (e *encoder) bytes(field int, b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	e.message(field, b)
+}
+
+func (e *encoder) string(field int, s string) {
+	e.bytes(field, []byte(s))
+}
+
+// message encodes an embedded message, which unlike a scalar is written even when empty
+func (e *encoder) message(field int, b []byte) {
+	e.tag(field, wireBytes)
+	e.varint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
@@ -0,0 +1,103 @@
+// Package txn builds, signs and serialises Helium blockchain transactions.
+//
+// Transactions are encoded as the protobuf blockchain_txn message the chain accepts, so the
+// bytes returned by Encode can be handed straight to PendingTransaction.Submit.
+//
+//	payment := &txn.PaymentV2{
+//		Payer:    payer,
+//		Payments: []txn.Payment{{Payee: payee, Amount: 100000000}},
+//		Fee:      35000,
+//		Nonce:    nonce,
+//	}
+//	err := payment.Sign(txn.Ed25519Signer(key))
+//	b, err := txn.Encode(payment)
+//	err = client.PendingTransaction().Submit(&helium.TransactionSubmitInput{Transaction: string(b)})
+package txn
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/dougkirkley/helium-go"
+)
+
+// Txn is a transaction that can be encoded for submission
+type Txn interface {
+	// envelopeField is the field number of the transaction in the blockchain_txn oneof
+	envelopeField() int
+	// encode returns the protobuf encoding of the transaction, without its signatures unless signed is set
+	encode(signed bool) ([]byte, error)
+}
+
+// Signer signs transaction bytes on behalf of an account, *helium.Keypair is a Signer
+type Signer interface {
+	Sign(message []byte) ([]byte, error)
+}
+
+// Ed25519Signer signs with an ed25519 private key
+type Ed25519Signer ed25519.PrivateKey
+
+// Sign signs message with the key
+func (k Ed25519Signer) Sign(message []byte) ([]byte, error) {
+	if len(k) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("txn: invalid ed25519 private key length %d", len(k))
+	}
+	return ed25519.Sign(ed25519.PrivateKey(k), message), nil
+}
+
+// Encode returns the blockchain_txn encoding of t, the bytes to submit to the chain
+func Encode(t Txn) ([]byte, error) {
+	b, err := t.encode(true)
+	if err != nil {
+		return nil, err
+	}
+	var e encoder
+	e.message(t.envelopeField(), b)
+	return e.buf, nil
+}
+
+// SigningBytes returns the bytes a signer signs, the transaction encoded without any signatures
+func SigningBytes(t Txn) ([]byte, error) {
+	return t.encode(false)
+}
+
+// Hash returns the transaction hash as reported by the api, the url safe base64 sha256 of the unsigned transaction
+func Hash(t Txn) (string, error) {
+	b, err := t.encode(false)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// sign signs the unsigned encoding of t with signer
+func sign(t Txn, signer Signer) ([]byte, error) {
+	b, err := t.encode(false)
+	if err != nil {
+		return nil, err
+	}
+	return signer.Sign(b)
+}
+
+// addressBytes returns the binary form of an address used in transactions, the key type byte followed by the public key
+func addressBytes(name string, address helium.Address) ([]byte, error) {
+	if address == "" {
+		return nil, fmt.Errorf("txn: %s address is required", name)
+	}
+	err := address.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("txn: %s: %w", name, err)
+	}
+	return address.Bytes(), nil
+}
+
+// signature returns sig when encoding a signed transaction and nothing otherwise
+func signature(sig []byte, signed bool) []byte {
+	if !signed {
+		return nil
+	}
+	return sig
+}
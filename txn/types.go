@@ -0,0 +1,325 @@
+package txn
+
+import (
+	"github.com/dougkirkley/helium-go"
+)
+
+// Field numbers of each transaction in the blockchain_txn oneof
+const (
+	fieldTokenBurnV1        = 17
+	fieldPaymentV2          = 21
+	fieldStakeValidatorV1   = 29
+	fieldUnstakeValidatorV1 = 31
+	fieldAssertLocationV2   = 35
+	fieldTransferHotspotV2  = 36
+)
+
+// Payment is a single payee of a PaymentV2
+type Payment struct {
+	Payee  helium.Address
+	Amount helium.HNT
+	Memo   uint64
+}
+
+func (p Payment) encode() ([]byte, error) {
+	payee, err := addressBytes("payee", p.Payee)
+	if err != nil {
+		return nil, err
+	}
+	var e encoder
+	e.bytes(1, payee)
+	e.amount(2, "amount", int64(p.Amount))
+	e.uint64(3, p.Memo)
+	return e.buf, e.err
+}
+
+// PaymentV2 pays HNT, in bones, from the payer to one or more payees
+type PaymentV2 struct {
+	Payer     helium.Address
+	Payments  []Payment
+	Fee       helium.DC
+	Nonce     uint64
+	Signature []byte
+}
+
+// Sign signs the payment as the payer
+func (t *PaymentV2) Sign(payer Signer) error {
+	sig, err := sign(t, payer)
+	if err != nil {
+		return err
+	}
+	t.Signature = sig
+	return nil
+}
+
+func (t *PaymentV2) envelopeField() int {
+	return fieldPaymentV2
+}
+
+func (t *PaymentV2) encode(signed bool) ([]byte, error) {
+	payer, err := addressBytes("payer", t.Payer)
+	if err != nil {
+		return nil, err
+	}
+	var e encoder
+	e.bytes(1, payer)
+	for _, p := range t.Payments {
+		b, err := p.encode()
+		if err != nil {
+			return nil, err
+		}
+		e.message(2, b)
+	}
+	e.amount(3, "fee", int64(t.Fee))
+	e.uint64(4, t.Nonce)
+	e.bytes(5, signature(t.Signature, signed))
+	return e.buf, e.err
+}
+
+// TokenBurnV1 burns HNT from the payer to credit the payee with data credits
+type TokenBurnV1 struct {
+	Payer     helium.Address
+	Payee     helium.Address
+	Amount    helium.HNT
+	Nonce     uint64
+	Signature []byte
+	Fee       helium.DC
+	Memo      uint64
+}
+
+// Sign signs the burn as the payer
+func (t *TokenBurnV1) Sign(payer Signer) error {
+	sig, err := sign(t, payer)
+	if err != nil {
+		return err
+	}
+	t.Signature = sig
+	return nil
+}
+
+func (t *TokenBurnV1) envelopeField() int {
+	return fieldTokenBurnV1
+}
+
+func (t *TokenBurnV1) encode(signed bool) ([]byte, error) {
+	payer, err := addressBytes("payer", t.Payer)
+	if err != nil {
+		return nil, err
+	}
+	payee, err := addressBytes("payee", t.Payee)
+	if err != nil {
+		return nil, err
+	}
+	var e encoder
+	e.bytes(1, payer)
+	e.bytes(2, payee)
+	e.amount(3, "amount", int64(t.Amount))
+	e.uint64(4, t.Nonce)
+	e.bytes(5, signature(t.Signature, signed))
+	e.amount(6, "fee", int64(t.Fee))
+	e.uint64(7, t.Memo)
+	return e.buf, e.err
+}
+
+// TransferHotspotV2 transfers a hotspot from its owner to a new owner
+type TransferHotspotV2 struct {
+	Gateway        helium.Address
+	Owner          helium.Address
+	OwnerSignature []byte
+	NewOwner       helium.Address
+	Fee            helium.DC
+	Nonce          uint64
+}
+
+// Sign signs the transfer as the current owner
+func (t *TransferHotspotV2) Sign(owner Signer) error {
+	sig, err := sign(t, owner)
+	if err != nil {
+		return err
+	}
+	t.OwnerSignature = sig
+	return nil
+}
+
+func (t *TransferHotspotV2) envelopeField() int {
+	return fieldTransferHotspotV2
+}
+
+func (t *TransferHotspotV2) encode(signed bool) ([]byte, error) {
+	gateway, err := addressBytes("gateway", t.Gateway)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := addressBytes("owner", t.Owner)
+	if err != nil {
+		return nil, err
+	}
+	newOwner, err := addressBytes("new owner", t.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+	var e encoder
+	e.bytes(1, gateway)
+	e.bytes(2, owner)
+	e.bytes(3, signature(t.OwnerSignature, signed))
+	e.bytes(4, newOwner)
+	e.amount(5, "fee", int64(t.Fee))
+	e.uint64(6, t.Nonce)
+	return e.buf, e.err
+}
+
+// AssertLocationV2 asserts the location, gain and elevation of a hotspot. Location is the
+// res 12 h3 index as a hex string, gain is in tenths of a dBi and elevation in metres.
+// The owner signs with Sign and, when another account pays the fees, the payer with SignPayer.
+type AssertLocationV2 struct {
+	Gateway        helium.Address
+	Owner          helium.Address
+	Payer          helium.Address
+	OwnerSignature []byte
+	PayerSignature []byte
+	Location       string
+	Nonce          uint64
+	Gain           int32
+	Elevation      int32
+	StakingFee     helium.DC
+	Fee            helium.DC
+}
+
+// Sign signs the assertion as the owner
+func (t *AssertLocationV2) Sign(owner Signer) error {
+	sig, err := sign(t, owner)
+	if err != nil {
+		return err
+	}
+	t.OwnerSignature = sig
+	return nil
+}
+
+// SignPayer signs the assertion as the payer
+func (t *AssertLocationV2) SignPayer(payer Signer) error {
+	sig, err := sign(t, payer)
+	if err != nil {
+		return err
+	}
+	t.PayerSignature = sig
+	return nil
+}
+
+func (t *AssertLocationV2) envelopeField() int {
+	return fieldAssertLocationV2
+}
+
+func (t *AssertLocationV2) encode(signed bool) ([]byte, error) {
+	gateway, err := addressBytes("gateway", t.Gateway)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := addressBytes("owner", t.Owner)
+	if err != nil {
+		return nil, err
+	}
+	var payer []byte
+	if t.Payer != "" {
+		payer, err = addressBytes("payer", t.Payer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var e encoder
+	e.bytes(1, gateway)
+	e.bytes(2, owner)
+	e.bytes(3, payer)
+	e.bytes(4, signature(t.OwnerSignature, signed))
+	e.bytes(5, signature(t.PayerSignature, signed))
+	e.string(6, t.Location)
+	e.uint64(7, t.Nonce)
+	e.int32(8, t.Gain)
+	e.int32(9, t.Elevation)
+	e.amount(10, "staking fee", int64(t.StakingFee))
+	e.amount(11, "fee", int64(t.Fee))
+	return e.buf, e.err
+}
+
+// StakeValidatorV1 stakes HNT, in bones, from the owner to a new validator
+type StakeValidatorV1 struct {
+	Address        helium.Address
+	Owner          helium.Address
+	Stake          helium.HNT
+	OwnerSignature []byte
+	Fee            helium.DC
+}
+
+// Sign signs the stake as the owner
+func (t *StakeValidatorV1) Sign(owner Signer) error {
+	sig, err := sign(t, owner)
+	if err != nil {
+		return err
+	}
+	t.OwnerSignature = sig
+	return nil
+}
+
+func (t *StakeValidatorV1) envelopeField() int {
+	return fieldStakeValidatorV1
+}
+
+func (t *StakeValidatorV1) encode(signed bool) ([]byte, error) {
+	address, err := addressBytes("validator", t.Address)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := addressBytes("owner", t.Owner)
+	if err != nil {
+		return nil, err
+	}
+	var e encoder
+	e.bytes(1, address)
+	e.bytes(2, owner)
+	e.amount(3, "stake", int64(t.Stake))
+	e.bytes(4, signature(t.OwnerSignature, signed))
+	e.amount(5, "fee", int64(t.Fee))
+	return e.buf, e.err
+}
+
+// UnstakeValidatorV1 returns a validator's stake to its owner at StakeReleaseHeight
+type UnstakeValidatorV1 struct {
+	Address            helium.Address
+	Owner              helium.Address
+	OwnerSignature     []byte
+	Fee                helium.DC
+	StakeAmount        helium.HNT
+	StakeReleaseHeight uint64
+}
+
+// Sign signs the unstake as the owner
+func (t *UnstakeValidatorV1) Sign(owner Signer) error {
+	sig, err := sign(t, owner)
+	if err != nil {
+		return err
+	}
+	t.OwnerSignature = sig
+	return nil
+}
+
+func (t *UnstakeValidatorV1) envelopeField() int {
+	return fieldUnstakeValidatorV1
+}
+
+func (t *UnstakeValidatorV1) encode(signed bool) ([]byte, error) {
+	address, err := addressBytes("validator", t.Address)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := addressBytes("owner", t.Owner)
+	if err != nil {
+		return nil, err
+	}
+	var e encoder
+	e.bytes(1, address)
+	e.bytes(2, owner)
+	e.bytes(3, signature(t.OwnerSignature, signed))
+	e.amount(4, "fee", int64(t.Fee))
+	e.amount(5, "stake amount", int64(t.StakeAmount))
+	e.uint64(6, t.StakeReleaseHeight)
+	return e.buf, e.err
+}
@@ -0,0 +1,499 @@
+package helium
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// TxnType is the type of a blockchain transaction as reported in its type field
+type TxnType string
+
+const (
+	TxnAddGatewayV1             TxnType = "add_gateway_v1"
+	TxnAssertLocationV1         TxnType = "assert_location_v1"
+	TxnAssertLocationV2         TxnType = "assert_location_v2"
+	TxnCoinbaseV1               TxnType = "coinbase_v1"
+	TxnConsensusGroupV1         TxnType = "consensus_group_v1"
+	TxnConsensusGroupFailureV1  TxnType = "consensus_group_failure_v1"
+	TxnCreateHtlcV1             TxnType = "create_htlc_v1"
+	TxnDcCoinbaseV1             TxnType = "dc_coinbase_v1"
+	TxnGenGatewayV1             TxnType = "gen_gateway_v1"
+	TxnOuiV1                    TxnType = "oui_v1"
+	TxnPaymentV1                TxnType = "payment_v1"
+	TxnPaymentV2                TxnType = "payment_v2"
+	TxnPocReceiptsV1            TxnType = "poc_receipts_v1"
+	TxnPocRequestV1             TxnType = "poc_request_v1"
+	TxnPriceOracleV1            TxnType = "price_oracle_v1"
+	TxnRedeemHtlcV1             TxnType = "redeem_htlc_v1"
+	TxnRewardsV1                TxnType = "rewards_v1"
+	TxnRewardsV2                TxnType = "rewards_v2"
+	TxnRoutingV1                TxnType = "routing_v1"
+	TxnSecurityCoinbaseV1       TxnType = "security_coinbase_v1"
+	TxnSecurityExchangeV1       TxnType = "security_exchange_v1"
+	TxnStakeValidatorV1         TxnType = "stake_validator_v1"
+	TxnStateChannelCloseV1      TxnType = "state_channel_close_v1"
+	TxnStateChannelOpenV1       TxnType = "state_channel_open_v1"
+	TxnTokenBurnExchangeRateV1  TxnType = "token_burn_exchange_rate_v1"
+	TxnTokenBurnV1              TxnType = "token_burn_v1"
+	TxnTransferHotspotV1        TxnType = "transfer_hotspot_v1"
+	TxnTransferHotspotV2        TxnType = "transfer_hotspot_v2"
+	TxnTransferValidatorStakeV1 TxnType = "transfer_validator_stake_v1"
+	TxnUnstakeValidatorV1       TxnType = "unstake_validator_v1"
+	TxnValidatorHeartbeatV1     TxnType = "validator_heartbeat_v1"
+	TxnVarsV1                   TxnType = "vars_v1"
+)
+
+// Txn is a blockchain transaction. The concrete type is selected by the type field when decoding,
+// use a type switch to get at its fields, types this package does not know and transactions that do not
+// fit their type decode to *UnknownTxn.
+//
+//	switch txn := txn.(type) {
+//	case *helium.PaymentV2:
+//		fmt.Println(txn.Payer, txn.Payments)
+//	case *helium.UnknownTxn:
+//		fmt.Println(txn.Type, string(txn.Raw))
+//	}
+type Txn interface {
+	TxnType() TxnType
+	TxnHash() string
+	TxnHeight() int
+	TxnTime() time.Time
+	header() *TxnHeader
+}
+
+// TxnHeader holds the fields common to every transaction, it is embedded in every Txn
+type TxnHeader struct {
+	Type   TxnType   `json:"type"`
+	Hash   string    `json:"hash,omitempty"`
+	Height int       `json:"height,omitempty"`
+	Time   Timestamp `json:"time"`
+}
+
+// TxnType returns the transaction's type
+func (h *TxnHeader) TxnType() TxnType {
+	return h.Type
+}
+
+// TxnHash returns the transaction's hash
+func (h *TxnHeader) TxnHash() string {
+	return h.Hash
+}
+
+// TxnHeight returns the height of the block the transaction is in, zero while pending
+func (h *TxnHeader) TxnHeight() int {
+	return h.Height
+}
+
+// TxnTime returns the time of the block the transaction is in, zero while pending
+func (h *TxnHeader) TxnTime() time.Time {
+	return h.Time.Time
+}
+
+func (h *TxnHeader) header() *TxnHeader {
+	return h
+}
+
+// UnknownTxn is a transaction of a type this package does not decode, or a transaction of a known type
+// whose JSON did not fit its concrete type, Raw keeps the JSON so nothing is lost
+type UnknownTxn struct {
+	TxnHeader
+	Raw json.RawMessage `json:"-"`
+	// Err is why a transaction of a known type could not be decoded, it is nil for unknown types
+	Err error `json:"-"`
+}
+
+// MarshalJSON returns the original JSON of the transaction
+func (t *UnknownTxn) MarshalJSON() ([]byte, error) {
+	if len(t.Raw) == 0 {
+		return json.Marshal(t.TxnHeader)
+	}
+	return t.Raw, nil
+}
+
+type PaymentV1 struct {
+	TxnHeader
+	Payer     Address `json:"payer"`
+	Payee     Address `json:"payee"`
+	Amount    HNT     `json:"amount"`
+	Fee       DC      `json:"fee"`
+	Nonce     int     `json:"nonce"`
+	Signature string  `json:"signature"`
+}
+
+type PaymentV2 struct {
+	TxnHeader
+	Payer     Address    `json:"payer"`
+	Payments  []Payments `json:"payments"`
+	Fee       DC         `json:"fee"`
+	Nonce     int        `json:"nonce"`
+	Signature string     `json:"signature"`
+}
+
+type RewardsV1 struct {
+	TxnHeader
+	StartEpoch int           `json:"start_epoch"`
+	EndEpoch   int           `json:"end_epoch"`
+	Rewards    []RewardEntry `json:"rewards"`
+}
+
+type RewardsV2 struct {
+	TxnHeader
+	StartEpoch int           `json:"start_epoch"`
+	EndEpoch   int           `json:"end_epoch"`
+	Rewards    []RewardEntry `json:"rewards"`
+}
+
+type CoinbaseV1 struct {
+	TxnHeader
+	Payee  Address `json:"payee"`
+	Amount HNT     `json:"amount"`
+}
+
+type DcCoinbaseV1 struct {
+	TxnHeader
+	Payee  Address `json:"payee"`
+	Amount DC      `json:"amount"`
+}
+
+type SecurityCoinbaseV1 struct {
+	TxnHeader
+	Payee  Address `json:"payee"`
+	Amount HST     `json:"amount"`
+}
+
+type SecurityExchangeV1 struct {
+	TxnHeader
+	Payer     Address `json:"payer"`
+	Payee     Address `json:"payee"`
+	Amount    HST     `json:"amount"`
+	Fee       DC      `json:"fee"`
+	Nonce     int     `json:"nonce"`
+	Signature string  `json:"signature"`
+}
+
+type TokenBurnV1 struct {
+	TxnHeader
+	Payer  Address `json:"payer"`
+	Payee  Address `json:"payee"`
+	Amount HNT     `json:"amount"`
+	Fee    DC      `json:"fee"`
+	Nonce  int     `json:"nonce"`
+	Memo   string  `json:"memo"`
+}
+
+type AddGatewayV1 struct {
+	TxnHeader
+	Gateway    Address `json:"gateway"`
+	Owner      Address `json:"owner"`
+	Payer      Address `json:"payer"`
+	Fee        DC      `json:"fee"`
+	StakingFee DC      `json:"staking_fee"`
+}
+
+type GenGatewayV1 struct {
+	TxnHeader
+	Gateway  Address `json:"gateway"`
+	Owner    Address `json:"owner"`
+	Location string  `json:"location"`
+	Nonce    int     `json:"nonce"`
+}
+
+type AssertLocationV1 struct {
+	TxnHeader
+	Gateway    Address `json:"gateway"`
+	Owner      Address `json:"owner"`
+	Payer      Address `json:"payer"`
+	Location   string  `json:"location"`
+	Lat        float64 `json:"lat"`
+	Lng        float64 `json:"lng"`
+	Nonce      int     `json:"nonce"`
+	Fee        DC      `json:"fee"`
+	StakingFee DC      `json:"staking_fee"`
+}
+
+type AssertLocationV2 struct {
+	TxnHeader
+	Gateway    Address `json:"gateway"`
+	Owner      Address `json:"owner"`
+	Payer      Address `json:"payer"`
+	Location   string  `json:"location"`
+	Lat        float64 `json:"lat"`
+	Lng        float64 `json:"lng"`
+	Gain       int     `json:"gain"`
+	Elevation  int     `json:"elevation"`
+	Nonce      int     `json:"nonce"`
+	Fee        DC      `json:"fee"`
+	StakingFee DC      `json:"staking_fee"`
+}
+
+type TransferHotspotV1 struct {
+	TxnHeader
+	Gateway        Address `json:"gateway"`
+	Seller         Address `json:"seller"`
+	Buyer          Address `json:"buyer"`
+	BuyerNonce     int     `json:"buyer_nonce"`
+	AmountToSeller HNT     `json:"amount_to_seller"`
+	Fee            DC      `json:"fee"`
+}
+
+type TransferHotspotV2 struct {
+	TxnHeader
+	Gateway  Address `json:"gateway"`
+	Owner    Address `json:"owner"`
+	NewOwner Address `json:"new_owner"`
+	Nonce    int     `json:"nonce"`
+	Fee      DC      `json:"fee"`
+}
+
+type StakeValidatorV1 struct {
+	TxnHeader
+	Address        Address `json:"address"`
+	Owner          Address `json:"owner"`
+	Stake          HNT     `json:"stake"`
+	Fee            DC      `json:"fee"`
+	OwnerSignature string  `json:"owner_signature"`
+}
+
+type UnstakeValidatorV1 struct {
+	TxnHeader
+	Address            Address `json:"address"`
+	Owner              Address `json:"owner"`
+	StakeAmount        HNT     `json:"stake_amount"`
+	StakeReleaseHeight int     `json:"stake_release_height"`
+	Fee                DC      `json:"fee"`
+}
+
+type TransferValidatorStakeV1 struct {
+	TxnHeader
+	OldAddress    Address `json:"old_address"`
+	NewAddress    Address `json:"new_address"`
+	OldOwner      Address `json:"old_owner"`
+	NewOwner      Address `json:"new_owner"`
+	StakeAmount   HNT     `json:"stake_amount"`
+	PaymentAmount HNT     `json:"payment_amount"`
+	Fee           DC      `json:"fee"`
+}
+
+type ValidatorHeartbeatV1 struct {
+	TxnHeader
+	Address     Address `json:"address"`
+	BlockHeight int     `json:"block_height"`
+	Signature   string  `json:"signature"`
+	Version     int     `json:"version"`
+}
+
+type StateChannelOpenV1 struct {
+	TxnHeader
+	ID           string  `json:"id"`
+	Owner        Address `json:"owner"`
+	Oui          int     `json:"oui"`
+	Nonce        int     `json:"nonce"`
+	Amount       DC      `json:"amount"`
+	ExpireWithin int     `json:"expire_within"`
+	Fee          DC      `json:"fee"`
+}
+
+// StateChannelSummary is the traffic a hotspot sent through a state channel
+type StateChannelSummary struct {
+	Client     Address `json:"client"`
+	Owner      Address `json:"owner,omitempty"`
+	Location   string  `json:"location,omitempty"`
+	NumPackets int     `json:"num_packets"`
+	NumDcs     DC      `json:"num_dcs"`
+}
+
+type StateChannelData struct {
+	ID            string                `json:"id"`
+	Owner         Address               `json:"owner"`
+	Nonce         int                   `json:"nonce"`
+	ExpireAtBlock int                   `json:"expire_at_block"`
+	State         string                `json:"state"`
+	RootHash      string                `json:"root_hash"`
+	Summaries     []StateChannelSummary `json:"summaries"`
+}
+
+type StateChannelCloseV1 struct {
+	TxnHeader
+	Closer        Address          `json:"closer"`
+	StateChannel  StateChannelData `json:"state_channel"`
+	ConflictsWith interface{}      `json:"conflicts_with,omitempty"`
+}
+
+type PocRequestV1 struct {
+	TxnHeader
+	Challenger         Address `json:"challenger"`
+	ChallengerOwner    Address `json:"challenger_owner,omitempty"`
+	ChallengerLocation string  `json:"challenger_location,omitempty"`
+	SecretHash         string  `json:"secret_hash"`
+	OnionKeyHash       string  `json:"onion_key_hash"`
+	BlockHash          string  `json:"block_hash"`
+	Version            int     `json:"version"`
+	Fee                DC      `json:"fee"`
+}
+
+type PocReceiptsV1 struct {
+	TxnHeader
+	Challenger         Address `json:"challenger"`
+	ChallengerOwner    Address `json:"challenger_owner,omitempty"`
+	ChallengerLocation string  `json:"challenger_location,omitempty"`
+	ChallengerLat      float64 `json:"challenger_lat,omitempty"`
+	ChallengerLon      float64 `json:"challenger_lon,omitempty"`
+	Secret             string  `json:"secret"`
+	OnionKeyHash       string  `json:"onion_key_hash"`
+	RequestBlockHash   string  `json:"request_block_hash,omitempty"`
+	Path               []Path  `json:"path"`
+	Fee                DC      `json:"fee"`
+}
+
+type ConsensusGroupV1 struct {
+	TxnHeader
+	Members []Address `json:"members"`
+	Proof   string    `json:"proof"`
+	Delay   int       `json:"delay"`
+}
+
+type PriceOracleV1 struct {
+	TxnHeader
+	PublicKey   Address `json:"public_key"`
+	Price       int     `json:"price"`
+	BlockHeight int     `json:"block_height"`
+	Fee         DC      `json:"fee"`
+}
+
+type OuiV1 struct {
+	TxnHeader
+	Owner               Address   `json:"owner"`
+	Oui                 int       `json:"oui"`
+	Payer               Address   `json:"payer"`
+	Addresses           []Address `json:"addresses"`
+	Filter              string    `json:"filter"`
+	RequestedSubnetSize int       `json:"requested_subnet_size"`
+	StakingFee          DC        `json:"staking_fee"`
+	Fee                 DC        `json:"fee"`
+}
+
+type RoutingV1 struct {
+	TxnHeader
+	Oui    int                    `json:"oui"`
+	Owner  Address                `json:"owner"`
+	Nonce  int                    `json:"nonce"`
+	Fee    DC                     `json:"fee"`
+	Action map[string]interface{} `json:"action"`
+}
+
+type VarsV1 struct {
+	TxnHeader
+	Vars             VarMap `json:"vars"`
+	VersionPredicate int    `json:"version_predicate"`
+	Nonce            int    `json:"nonce"`
+}
+
+// txnTypes maps each decoded transaction type to a constructor for its concrete type
+var txnTypes = map[TxnType]func() Txn{
+	TxnAddGatewayV1:             func() Txn { return &AddGatewayV1{} },
+	TxnAssertLocationV1:         func() Txn { return &AssertLocationV1{} },
+	TxnAssertLocationV2:         func() Txn { return &AssertLocationV2{} },
+	TxnCoinbaseV1:               func() Txn { return &CoinbaseV1{} },
+	TxnConsensusGroupV1:         func() Txn { return &ConsensusGroupV1{} },
+	TxnDcCoinbaseV1:             func() Txn { return &DcCoinbaseV1{} },
+	TxnGenGatewayV1:             func() Txn { return &GenGatewayV1{} },
+	TxnOuiV1:                    func() Txn { return &OuiV1{} },
+	TxnPaymentV1:                func() Txn { return &PaymentV1{} },
+	TxnPaymentV2:                func() Txn { return &PaymentV2{} },
+	TxnPocReceiptsV1:            func() Txn { return &PocReceiptsV1{} },
+	TxnPocRequestV1:             func() Txn { return &PocRequestV1{} },
+	TxnPriceOracleV1:            func() Txn { return &PriceOracleV1{} },
+	TxnRewardsV1:                func() Txn { return &RewardsV1{} },
+	TxnRewardsV2:                func() Txn { return &RewardsV2{} },
+	TxnRoutingV1:                func() Txn { return &RoutingV1{} },
+	TxnSecurityCoinbaseV1:       func() Txn { return &SecurityCoinbaseV1{} },
+	TxnSecurityExchangeV1:       func() Txn { return &SecurityExchangeV1{} },
+	TxnStakeValidatorV1:         func() Txn { return &StakeValidatorV1{} },
+	TxnStateChannelCloseV1:      func() Txn { return &StateChannelCloseV1{} },
+	TxnStateChannelOpenV1:       func() Txn { return &StateChannelOpenV1{} },
+	TxnTokenBurnV1:              func() Txn { return &TokenBurnV1{} },
+	TxnTransferHotspotV1:        func() Txn { return &TransferHotspotV1{} },
+	TxnTransferHotspotV2:        func() Txn { return &TransferHotspotV2{} },
+	TxnTransferValidatorStakeV1: func() Txn { return &TransferValidatorStakeV1{} },
+	TxnUnstakeValidatorV1:       func() Txn { return &UnstakeValidatorV1{} },
+	TxnValidatorHeartbeatV1:     func() Txn { return &ValidatorHeartbeatV1{} },
+	TxnVarsV1:                   func() Txn { return &VarsV1{} },
+}
+
+// DecodeTxn decodes a transaction into the concrete type named by its type field
+func DecodeTxn(data []byte) (Txn, error) {
+	var typed struct {
+		Type TxnType `json:"type"`
+	}
+	err := json.Unmarshal(data, &typed)
+	if err != nil {
+		return nil, err
+	}
+	return decodeTxnAs(typed.Type, data)
+}
+
+// decodeTxnAs decodes data as a transaction of type txnType, for transactions whose
+// type is given outside of the transaction itself such as pending transactions.
+// A transaction that does not fit its concrete type decodes to an *UnknownTxn carrying the error,
+// so one odd transaction does not fail the block or page it is in.
+func decodeTxnAs(txnType TxnType, data []byte) (Txn, error) {
+	newTxn, ok := txnTypes[txnType]
+	if ok {
+		txn := newTxn()
+		err := json.Unmarshal(data, txn)
+		if err == nil {
+			txn.header().Type = txnType
+			return txn, nil
+		}
+		return unknownTxn(txnType, data, err), nil
+	}
+	return unknownTxn(txnType, data, nil), nil
+}
+
+// unknownTxn keeps data as an UnknownTxn, decoding as much of its header as it can
+func unknownTxn(txnType TxnType, data []byte, decodeErr error) *UnknownTxn {
+	txn := &UnknownTxn{Raw: append(json.RawMessage(nil), data...), Err: decodeErr}
+	err := json.Unmarshal(data, &txn.TxnHeader)
+	if err != nil && txn.Err == nil {
+		txn.Err = err
+	}
+	txn.Type = txnType
+	return txn
+}
+
+// Txns is a list of transactions of mixed types
+type Txns []Txn
+
+// UnmarshalJSON decodes each transaction into its concrete type
+func (t *Txns) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	txns := make(Txns, 0, len(raw))
+	for _, r := range raw {
+		txn, err := DecodeTxn(r)
+		if err != nil {
+			return err
+		}
+		txns = append(txns, txn)
+	}
+	*t = txns
+	return nil
+}
+
+// decodePendingTxn decodes the txn field of a pending transaction, which carries neither its type nor its hash
+func decodePendingTxn(txnType TxnType, hash string, data json.RawMessage) (Txn, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	txn, err := decodeTxnAs(txnType, data)
+	if err != nil {
+		return nil, err
+	}
+	if txn.header().Hash == "" {
+		txn.header().Hash = hash
+	}
+	return txn, nil
+}
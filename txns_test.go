@@ -0,0 +1,102 @@
+package helium
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testAccount is a valid account address
+const testAccount = "13WRNw4fmssJBvMqMnREwe1eCvUVXfnWXSXGcWXyVvAnQUF3D9R"
+
+func TestDecodeTxns(t *testing.T) {
+	var txns Transactions
+	err := json.Unmarshal([]byte(`{"data":[
+		{"type":"payment_v2","hash":"h1","height":10,"time":1600000000,"payer":"`+testAddress+`","payments":[{"payee":"`+testAccount+`","amount":5}],"fee":35000,"nonce":3},
+		{"type":"rewards_v2","hash":"h2","start_epoch":1,"end_epoch":30,"rewards":[{"type":"poc_witnesses","account":"`+testAccount+`","amount":12}]},
+		{"type":"token_burn_v1","hash":"h3","payer":"`+testAddress+`","payee":"`+testAccount+`","amount":100},
+		{"type":"future_txn_v9","hash":"h4","height":11,"novel":true}
+	],"cursor":"next"}`), &txns)
+	if !assert.NoError(t, err) || !assert.Len(t, txns.Data, 4) {
+		return
+	}
+	assert.Equal(t, "next", txns.Cursor)
+
+	payment, ok := txns.Data[0].(*PaymentV2)
+	if assert.True(t, ok) {
+		assert.Equal(t, TxnPaymentV2, payment.TxnType())
+		assert.Equal(t, "h1", payment.TxnHash())
+		assert.Equal(t, 10, payment.TxnHeight())
+		assert.Equal(t, Address(testAccount), payment.Payments[0].Payee)
+	}
+	rewards, ok := txns.Data[1].(*RewardsV2)
+	if assert.True(t, ok) {
+		assert.Equal(t, HNT(12), rewards.Rewards[0].Amount)
+	}
+	burn, ok := txns.Data[2].(*TokenBurnV1)
+	if assert.True(t, ok) {
+		assert.Equal(t, HNT(100), burn.Amount)
+	}
+	unknown, ok := txns.Data[3].(*UnknownTxn)
+	if assert.True(t, ok) {
+		assert.Equal(t, TxnType("future_txn_v9"), unknown.TxnType())
+		assert.Equal(t, 11, unknown.TxnHeight())
+		out, err := json.Marshal(unknown)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"type":"future_txn_v9","hash":"h4","height":11,"novel":true}`, string(out))
+	}
+}
+
+func TestDecodePendingTxn(t *testing.T) {
+	var pending PendingTransactionData
+	err := json.Unmarshal([]byte(`{"type":"payment_v2","hash":"p1","status":"pending",
+		"txn":{"payer":"`+testAddress+`","payments":[{"payee":"`+testAccount+`","amount":5}],"fee":35000,"nonce":3}}`), &pending)
+	assert.NoError(t, err)
+	payment, ok := pending.Txn.(*PaymentV2)
+	if assert.True(t, ok) {
+		assert.Equal(t, TxnPaymentV2, payment.Type)
+		assert.Equal(t, "p1", payment.Hash)
+		assert.Equal(t, Address(testAddress), payment.Payer)
+	}
+}
+
+func TestTransactionGet(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/transactions/h1", r.URL.Path)
+		w.Write([]byte(`{"data":{"type":"stake_validator_v1","hash":"h1","address":"` + testAddress + `","owner":"` + testAccount + `","stake":1000000000000}}`))
+	}))
+	info, err := client.Transaction().Get("h1")
+	assert.NoError(t, err)
+	stake, ok := info.Data.(*StakeValidatorV1)
+	if assert.True(t, ok) {
+		assert.Equal(t, Address(testAddress), stake.Address)
+		assert.Equal(t, HNT(1000000000000), stake.Stake)
+	}
+}
+
+func TestDecodeTxnsMalformed(t *testing.T) {
+	var txns Txns
+	err := json.Unmarshal([]byte(`[
+		{"type":"token_burn_v1","hash":"h1","payer":"`+testAddress+`","payee":"`+testAccount+`","amount":100},
+		{"type":"payment_v2","hash":"h2","height":10,"payer":"`+testAddress+`","payments":{"payee":"`+testAccount+`"},"fee":35000},
+		{"type":"payment_v2","hash":"h3","height":11,"payer":"`+testAddress+`","payments":[{"payee":"`+testAccount+`","amount":5}]}
+	]`), &txns)
+	if !assert.NoError(t, err) || !assert.Len(t, txns, 3) {
+		return
+	}
+	_, ok := txns[0].(*TokenBurnV1)
+	assert.True(t, ok)
+	_, ok = txns[2].(*PaymentV2)
+	assert.True(t, ok)
+
+	malformed, ok := txns[1].(*UnknownTxn)
+	if assert.True(t, ok) {
+		assert.Error(t, malformed.Err)
+		assert.Equal(t, TxnPaymentV2, malformed.TxnType())
+		assert.Equal(t, "h2", malformed.TxnHash())
+		assert.Equal(t, 10, malformed.TxnHeight())
+		assert.Contains(t, string(malformed.Raw), `"payments":{`)
+	}
+}
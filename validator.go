@@ -2,10 +2,10 @@ package helium
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 // Validator handles api endpoint /validators docs located at https://docs.helium.com/api/blockchain/validators
@@ -27,15 +27,15 @@ type ValidatorData struct {
 	VersionHeartbeat int           `json:"version_heartbeat"`
 	Status           Status        `json:"status"`
 	StakeStatus      string        `json:"stake_status"`
-	Stake            int64         `json:"stake"`
+	Stake            HNT           `json:"stake"`
 	Penalty          int           `json:"penalty"`
 	Penalties        []interface{} `json:"penalties"`
-	Owner            string        `json:"owner"`
+	Owner            Address       `json:"owner"`
 	Name             string        `json:"name"`
 	LastHeartbeat    int           `json:"last_heartbeat"`
 	BlockAdded       int           `json:"block_added"`
 	Block            int           `json:"block"`
-	Address          string        `json:"address"`
+	Address          Address       `json:"address"`
 }
 
 type ValidatorInfo struct {
@@ -54,13 +54,13 @@ type ValidatorActivity struct {
 }
 
 type ValidatorActivityData struct {
-	Address   string `json:"address"`
-	Hash      string `json:"hash"`
-	Height    int    `json:"height"`
-	Signature string `json:"signature"`
-	Time      int    `json:"time"`
-	Type      string `json:"type"`
-	Version   int    `json:"version"`
+	Address   Address   `json:"address"`
+	Hash      string    `json:"hash"`
+	Height    int       `json:"height"`
+	Signature string    `json:"signature"`
+	Time      Timestamp `json:"time"`
+	Type      string    `json:"type"`
+	Version   int       `json:"version"`
 }
 
 type ValidatorActivityCount struct {
@@ -132,31 +132,32 @@ type ValidatorElections struct {
 }
 
 type ValidatorElectionData struct {
-	VersionHeartbeat int    `json:"version_heartbeat"`
-	Status           string `json:"status"`
-	Stake            int64  `json:"stake"`
-	Owner            string `json:"owner"`
-	LastHeartbeat    int    `json:"last_heartbeat"`
-	Block            int    `json:"block"`
-	Address          string `json:"address"`
+	VersionHeartbeat int     `json:"version_heartbeat"`
+	Status           string  `json:"status"`
+	Stake            HNT     `json:"stake"`
+	Owner            Address `json:"owner"`
+	LastHeartbeat    int     `json:"last_heartbeat"`
+	Block            int     `json:"block"`
+	Address          Address `json:"address"`
 }
 
 type ValidatorRewards struct {
-	Data []ValidatorRewardData `json:"data"`
+	Data   []ValidatorRewardData `json:"data"`
+	Cursor string                `json:"cursor"`
 }
 
 type ValidatorRewardData struct {
-	Account   string    `json:"account"`
-	Amount    int       `json:"amount"`
+	Account   Address   `json:"account"`
+	Amount    HNT       `json:"amount"`
 	Block     int       `json:"block"`
-	Gateway   string    `json:"gateway"`
+	Gateway   Address   `json:"gateway"`
 	Hash      string    `json:"hash"`
-	Timestamp time.Time `json:"timestamp"`
+	Timestamp Timestamp `json:"timestamp"`
 }
 
 type ValidatorRewardsSum struct {
 	Data ValidatorRewardsSumData `json:"data"`
-	Meta Meta `json:"meta"`
+	Meta Meta                    `json:"meta"`
 }
 
 type ValidatorRewardsSumData struct {
@@ -165,18 +166,43 @@ type ValidatorRewardsSumData struct {
 	Median float64 `json:"median"`
 	Min    float64 `json:"min"`
 	Stddev float64 `json:"stddev"`
-	Sum    int64   `json:"sum"`
+	Sum    HNT     `json:"sum"`
 	Total  float64 `json:"total"`
 }
 
+type ValidatorListInput struct {
+	Cursor string
+}
+
+// ValidatorActivityInput narrows the activity or roles of a validator to transaction types and a time window,
+// Limit caps the number of transactions per page
+type ValidatorActivityInput struct {
+	Address     Address
+	FilterTypes []TxnType
+	MinTime     TimeBound
+	MaxTime     TimeBound
+	Limit       int
+	Cursor      string
+}
+
 type Meta struct {
-	MaxTime time.Time `json:"max_time"`
-	MinTime time.Time `json:"min_time"`
+	MaxTime Timestamp `json:"max_time"`
+	MinTime Timestamp `json:"min_time"`
+	Bucket  Bucket    `json:"bucket,omitempty"`
 }
 
 // List list known validators as registered on the blockchain.
-func (v *Validator) List() (*Validators, error) {
-	resp, err := v.c.Request(http.MethodGet, "/validators", new(bytes.Buffer), nil)
+func (v *Validator) List(input *ValidatorListInput) (*Validators, error) {
+	return v.ListWithContext(context.Background(), input)
+}
+
+// ListWithContext is List with a caller supplied context.
+func (v *Validator) ListWithContext(ctx context.Context, input *ValidatorListInput) (*Validators, error) {
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, "/validators", new(bytes.Buffer), params)
 	if err != nil {
 		return &Validators{}, err
 	}
@@ -190,9 +216,25 @@ func (v *Validator) List() (*Validators, error) {
 	return validators, nil
 }
 
+// ListAll iterates over every validator registered on the blockchain.
+func (v *Validator) ListAll(opts ...PagerOption) *ValidatorPager {
+	return NewValidatorPager(func(ctx context.Context, cursor string) (*Validators, error) {
+		return v.ListWithContext(ctx, &ValidatorListInput{Cursor: cursor})
+	}, opts...)
+}
+
 // Get Fetch a validator with a given address.
-func (v *Validator) Get(address string) (*ValidatorInfo, error) {
-	resp, err := v.c.Request(http.MethodGet, fmt.Sprintf("/validators/%s", address), new(bytes.Buffer), nil)
+func (v *Validator) Get(address Address) (*ValidatorInfo, error) {
+	return v.GetWithContext(context.Background(), address)
+}
+
+// GetWithContext is Get with a caller supplied context.
+func (v *Validator) GetWithContext(ctx context.Context, address Address) (*ValidatorInfo, error) {
+	err := address.Validate()
+	if err != nil {
+		return &ValidatorInfo{}, err
+	}
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/%s", address), new(bytes.Buffer), nil)
 	if err != nil {
 		return &ValidatorInfo{}, err
 	}
@@ -206,9 +248,14 @@ func (v *Validator) Get(address string) (*ValidatorInfo, error) {
 	return validatorInfo, nil
 }
 
-// GetByName Fetch the validators which map to the given 3-word animal name. 
+// GetByName Fetch the validators which map to the given 3-word animal name.
 func (v *Validator) GetByName(name string) (*ValidatorInfo, error) {
-	resp, err := v.c.Request(http.MethodGet, fmt.Sprintf("/validators/name/%s", name), new(bytes.Buffer), nil)
+	return v.GetByNameWithContext(context.Background(), name)
+}
+
+// GetByNameWithContext is GetByName with a caller supplied context.
+func (v *Validator) GetByNameWithContext(ctx context.Context, name string) (*ValidatorInfo, error) {
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/name/%s", name), new(bytes.Buffer), nil)
 	if err != nil {
 		return &ValidatorInfo{}, err
 	}
@@ -224,12 +271,17 @@ func (v *Validator) GetByName(name string) (*ValidatorInfo, error) {
 
 // Search Fetch the validators which match a search term in the given search term query parameter.
 func (v *Validator) Search(term string) (*Validators, error) {
+	return v.SearchWithContext(context.Background(), term)
+}
+
+// SearchWithContext is Search with a caller supplied context.
+func (v *Validator) SearchWithContext(ctx context.Context, term string) (*Validators, error) {
 	if len(term) < 1 {
 		return &Validators{}, fmt.Errorf("search term must be 1 character or more, 3 is recommended")
 	}
 	params := make(map[string]string)
 	params["search"] = term
-	resp, err := v.c.Request(http.MethodGet, "/validators/name", new(bytes.Buffer), params)
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, "/validators/name", new(bytes.Buffer), params)
 	if err != nil {
 		return &Validators{}, err
 	}
@@ -244,8 +296,22 @@ func (v *Validator) Search(term string) (*Validators, error) {
 }
 
 // Activity Lists all blockchain transactions that the given validator was involved in.
-func (v *Validator) Activity(address string) (*ValidatorActivity, error) {
-	resp, err := v.c.Request(http.MethodGet, fmt.Sprintf("/validators/%s/activity", address), new(bytes.Buffer), nil)
+func (v *Validator) Activity(input *ValidatorActivityInput) (*ValidatorActivity, error) {
+	return v.ActivityWithContext(context.Background(), input)
+}
+
+// ActivityWithContext is Activity with a caller supplied context.
+func (v *Validator) ActivityWithContext(ctx context.Context, input *ValidatorActivityInput) (*ValidatorActivity, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &ValidatorActivity{}, err
+	}
+	params := make(map[string]string)
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	activityParams(params, input.FilterTypes, input.MinTime, input.MaxTime, input.Limit)
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/%s/activity", input.Address), new(bytes.Buffer), params)
 	if err != nil {
 		return &ValidatorActivity{}, err
 	}
@@ -259,13 +325,68 @@ func (v *Validator) Activity(address string) (*ValidatorActivity, error) {
 	return validatorActivity, nil
 }
 
-// ActivityCount Count transactions that indicate activity for a validator.
-func (v *Validator) ActivityCount(address string, filterTypes string) (*ValidatorActivityCount, error) {
+// ActivityAll iterates over every blockchain transaction that the given validator was involved in.
+func (v *Validator) ActivityAll(input *ValidatorActivityInput, opts ...PagerOption) *ValidatorActivityPager {
+	return NewValidatorActivityPager(func(ctx context.Context, cursor string) (*ValidatorActivity, error) {
+		page := *input
+		page.Cursor = cursor
+		return v.ActivityWithContext(ctx, &page)
+	}, opts...)
+}
+
+// Roles Lists the blockchain transactions the given validator took part in and its role in each.
+func (v *Validator) Roles(input *ValidatorActivityInput) (*Roles, error) {
+	return v.RolesWithContext(context.Background(), input)
+}
+
+// RolesWithContext is Roles with a caller supplied context.
+func (v *Validator) RolesWithContext(ctx context.Context, input *ValidatorActivityInput) (*Roles, error) {
+	err := input.Address.Validate()
+	if err != nil {
+		return &Roles{}, err
+	}
 	params := make(map[string]string)
-	if len(filterTypes) > 0 {
-		params["filter_types"] = filterTypes
+	if len(input.Cursor) > 0 {
+		params["cursor"] = input.Cursor
+	}
+	activityParams(params, input.FilterTypes, input.MinTime, input.MaxTime, input.Limit)
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/%s/roles", input.Address), new(bytes.Buffer), params)
+	if err != nil {
+		return &Roles{}, err
+	}
+	defer resp.Body.Close()
+
+	var roles *Roles
+	err = json.NewDecoder(resp.Body).Decode(&roles)
+	if err != nil {
+		return &Roles{}, err
+	}
+	return roles, nil
+}
+
+// RolesAll iterates over every blockchain transaction the given validator took part in.
+func (v *Validator) RolesAll(input *ValidatorActivityInput, opts ...PagerOption) *RolePager {
+	return NewRolePager(func(ctx context.Context, cursor string) (*Roles, error) {
+		page := *input
+		page.Cursor = cursor
+		return v.RolesWithContext(ctx, &page)
+	}, opts...)
+}
+
+// ActivityCount Count transactions that indicate activity for a validator, counting only filterTypes when given.
+func (v *Validator) ActivityCount(address Address, filterTypes []TxnType) (*ValidatorActivityCount, error) {
+	return v.ActivityCountWithContext(context.Background(), address, filterTypes)
+}
+
+// ActivityCountWithContext is ActivityCount with a caller supplied context.
+func (v *Validator) ActivityCountWithContext(ctx context.Context, address Address, filterTypes []TxnType) (*ValidatorActivityCount, error) {
+	err := address.Validate()
+	if err != nil {
+		return &ValidatorActivityCount{}, err
 	}
-	resp, err := v.c.Request(http.MethodGet, fmt.Sprintf("/validators/%s/activity/count", address), new(bytes.Buffer), params)
+	params := make(map[string]string)
+	activityParams(params, filterTypes, "", "", 0)
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/%s/activity/count", address), new(bytes.Buffer), params)
 	if err != nil {
 		return &ValidatorActivityCount{}, err
 	}
@@ -280,8 +401,13 @@ func (v *Validator) ActivityCount(address string, filterTypes string) (*Validato
 }
 
 // Stats Returns stats for validators
-func (v *Validator) Stats(address string) (*ValidatorStats, error) {
-	resp, err := v.c.Request(http.MethodGet, "/validators/stats", new(bytes.Buffer), nil)
+func (v *Validator) Stats() (*ValidatorStats, error) {
+	return v.StatsWithContext(context.Background())
+}
+
+// StatsWithContext is Stats with a caller supplied context.
+func (v *Validator) StatsWithContext(ctx context.Context) (*ValidatorStats, error) {
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, "/validators/stats", new(bytes.Buffer), nil)
 	if err != nil {
 		return &ValidatorStats{}, err
 	}
@@ -297,7 +423,12 @@ func (v *Validator) Stats(address string) (*ValidatorStats, error) {
 
 // ListElected Returns the list of validators that are currently elected to the consensus group.
 func (v *Validator) ListElected() (*ValidatorElections, error) {
-	resp, err := v.c.Request(http.MethodGet, "/validators/elected", new(bytes.Buffer), nil)
+	return v.ListElectedWithContext(context.Background())
+}
+
+// ListElectedWithContext is ListElected with a caller supplied context.
+func (v *Validator) ListElectedWithContext(ctx context.Context) (*ValidatorElections, error) {
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, "/validators/elected", new(bytes.Buffer), nil)
 	if err != nil {
 		return &ValidatorElections{}, err
 	}
@@ -312,8 +443,13 @@ func (v *Validator) ListElected() (*ValidatorElections, error) {
 }
 
 // ElectedAtHeight Returns the list of validators that were in the consensus group at a given block height
-func (v *Validator) ElectedAtHeight(height string,) (*Validators, error) {
-	resp, err := v.c.Request(http.MethodGet, fmt.Sprintf("/validators/elected/%s", height), new(bytes.Buffer), nil)
+func (v *Validator) ElectedAtHeight(height string) (*Validators, error) {
+	return v.ElectedAtHeightWithContext(context.Background(), height)
+}
+
+// ElectedAtHeightWithContext is ElectedAtHeight with a caller supplied context.
+func (v *Validator) ElectedAtHeightWithContext(ctx context.Context, height string) (*Validators, error) {
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/elected/%s", height), new(bytes.Buffer), nil)
 	if err != nil {
 		return &Validators{}, err
 	}
@@ -328,8 +464,13 @@ func (v *Validator) ElectedAtHeight(height string,) (*Validators, error) {
 }
 
 // ElectedAtHash Returns the list of validators that were elected in the consensus group transcation indicated by the given transaction hash.
-func (v *Validator) ElectedAtHash(hash string,) (*Validators, error) {
-	resp, err := v.c.Request(http.MethodGet, fmt.Sprintf("/validators/elected/hash/%s", hash), new(bytes.Buffer), nil)
+func (v *Validator) ElectedAtHash(hash string) (*Validators, error) {
+	return v.ElectedAtHashWithContext(context.Background(), hash)
+}
+
+// ElectedAtHashWithContext is ElectedAtHash with a caller supplied context.
+func (v *Validator) ElectedAtHashWithContext(ctx context.Context, hash string) (*Validators, error) {
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/elected/hash/%s", hash), new(bytes.Buffer), nil)
 	if err != nil {
 		return &Validators{}, err
 	}
@@ -343,41 +484,96 @@ func (v *Validator) ElectedAtHash(hash string,) (*Validators, error) {
 	return validators, nil
 }
 
-// Rewards Returns rewards for a given validator per reward block the validator is in, for a given timeframe. 
-func (v *Validator) Rewards(address string, cursor string, maxTime string, minTime string) (*Validators, error) {
+// Rewards Returns rewards for a given validator per reward block the validator is in, for a given timeframe.
+func (v *Validator) Rewards(address Address, cursor string, maxTime TimeBound, minTime TimeBound) (*ValidatorRewards, error) {
+	return v.RewardsWithContext(context.Background(), address, cursor, maxTime, minTime)
+}
+
+// RewardsWithContext is Rewards with a caller supplied context.
+func (v *Validator) RewardsWithContext(ctx context.Context, address Address, cursor string, maxTime TimeBound, minTime TimeBound) (*ValidatorRewards, error) {
+	err := address.Validate()
+	if err != nil {
+		return &ValidatorRewards{}, err
+	}
 	params := make(map[string]string)
 	if len(cursor) > 0 {
 		params["cursor"] = cursor
 	}
-	params["max_time"] = maxTime
-	params["min_time"] = minTime
-
-	resp, err := v.c.Request(http.MethodGet, fmt.Sprintf("/validators/%s/rewards", address), new(bytes.Buffer), params)
+	timeParams(params, minTime, maxTime)
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/%s/rewards", address), new(bytes.Buffer), params)
 	if err != nil {
-		return &Validators{}, err
+		return &ValidatorRewards{}, err
 	}
 	defer resp.Body.Close()
 
-	var validators *Validators
-	err = json.NewDecoder(resp.Body).Decode(&validators)
+	var validatorRewards *ValidatorRewards
+	err = json.NewDecoder(resp.Body).Decode(&validatorRewards)
 	if err != nil {
-		return &Validators{}, err
+		return &ValidatorRewards{}, err
 	}
-	return validators, nil
+	return validatorRewards, nil
+}
+
+// RewardsAll iterates over every reward for a given validator in a timeframe.
+func (v *Validator) RewardsAll(address Address, maxTime TimeBound, minTime TimeBound, opts ...PagerOption) *ValidatorRewardPager {
+	return NewValidatorRewardPager(func(ctx context.Context, cursor string) (*ValidatorRewards, error) {
+		return v.RewardsWithContext(ctx, address, cursor, maxTime, minTime)
+	}, opts...)
 }
 
 // RewardsSum Returns the total rewards earned for a given validator over a given time range.
-func (v *Validator) RewardsSum(address string,) (*ValidatorRewardsSum, error) {
-	resp, err := v.c.Request(http.MethodGet, fmt.Sprintf("/validators/%s/rewards/sum", address), new(bytes.Buffer), nil)
+func (v *Validator) RewardsSum(address Address, maxTime TimeBound, minTime TimeBound) (*ValidatorRewardsSum, error) {
+	return v.RewardsSumWithContext(context.Background(), address, maxTime, minTime)
+}
+
+// RewardsSumWithContext is RewardsSum with a caller supplied context.
+func (v *Validator) RewardsSumWithContext(ctx context.Context, address Address, maxTime TimeBound, minTime TimeBound) (*ValidatorRewardsSum, error) {
+	err := address.Validate()
+	if err != nil {
+		return &ValidatorRewardsSum{}, err
+	}
+	params := make(map[string]string)
+	timeParams(params, minTime, maxTime)
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/%s/rewards/sum", address), new(bytes.Buffer), params)
 	if err != nil {
 		return &ValidatorRewardsSum{}, err
 	}
 	defer resp.Body.Close()
-	
+
 	var validatorRewardsSum *ValidatorRewardsSum
 	err = json.NewDecoder(resp.Body).Decode(&validatorRewardsSum)
 	if err != nil {
 		return &ValidatorRewardsSum{}, err
 	}
 	return validatorRewardsSum, nil
-}
\ No newline at end of file
+}
+
+// RewardsSumBuckets Returns the rewards earned by a given validator over a given time range, summed per bucket.
+func (v *Validator) RewardsSumBuckets(address Address, maxTime TimeBound, minTime TimeBound, bucket Bucket) (*RewardBuckets, error) {
+	return v.RewardsSumBucketsWithContext(context.Background(), address, maxTime, minTime, bucket)
+}
+
+// RewardsSumBucketsWithContext is RewardsSumBuckets with a caller supplied context.
+func (v *Validator) RewardsSumBucketsWithContext(ctx context.Context, address Address, maxTime TimeBound, minTime TimeBound, bucket Bucket) (*RewardBuckets, error) {
+	err := address.Validate()
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	params := make(map[string]string)
+	err = bucketParams(params, minTime, maxTime, bucket)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/validators/%s/rewards/sum", address), new(bytes.Buffer), params)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	defer resp.Body.Close()
+
+	var rewardBuckets *RewardBuckets
+	err = json.NewDecoder(resp.Body).Decode(&rewardBuckets)
+	if err != nil {
+		return &RewardBuckets{}, err
+	}
+	return rewardBuckets, nil
+}
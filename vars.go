@@ -0,0 +1,287 @@
+package helium
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Vars handles the chain variables at /vars
+type Vars struct {
+	c *Client
+}
+
+// Vars returns the Vars client
+func (c *Client) Vars() *Vars {
+	return &Vars{c}
+}
+
+var (
+	// ErrVarNotFound is returned by the VarMap accessors for a variable that is not in the map
+	ErrVarNotFound = errors.New("helium: var not found")
+	// ErrVarKind is returned by the VarMap accessors for a variable of a different kind than asked for
+	ErrVarKind = errors.New("helium: unexpected var kind")
+)
+
+// VarKind is the kind of value a chain variable holds
+type VarKind int
+
+const (
+	VarInt VarKind = iota
+	VarFloat
+	VarString
+	// VarOther is a value of any other JSON type, it is only kept as Raw
+	VarOther
+)
+
+func (k VarKind) String() string {
+	switch k {
+	case VarInt:
+		return "int"
+	case VarFloat:
+		return "float"
+	case VarString:
+		return "string"
+	case VarOther:
+		return "other"
+	}
+	return fmt.Sprintf("VarKind(%d)", int(k))
+}
+
+// Var is a chain variable, the field matching Kind holds its value. Variables holding keys or other
+// binary values are kept as the base64 string the api returns, VarMap.Binary decodes them.
+type Var struct {
+	Name   string
+	Kind   VarKind
+	Int    int64
+	Float  float64
+	String string
+	Raw    json.RawMessage
+}
+
+// UnmarshalJSON decodes whole numbers as VarInt, other numbers as VarFloat and strings as VarString
+func (v *Var) UnmarshalJSON(data []byte) error {
+	*v = Var{Name: v.Name}
+	data = bytes.TrimSpace(data)
+	switch {
+	case len(data) > 0 && data[0] == '"':
+		v.Kind = VarString
+		return json.Unmarshal(data, &v.String)
+	case len(data) > 0 && (data[0] == '-' || data[0] >= '0' && data[0] <= '9'):
+		n, err := strconv.ParseInt(string(data), 10, 64)
+		if err == nil {
+			v.Kind = VarInt
+			v.Int = n
+			return nil
+		}
+		v.Kind = VarFloat
+		return json.Unmarshal(data, &v.Float)
+	}
+	v.Kind = VarOther
+	v.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON encodes the value of the Var in the form the api returns it
+func (v Var) MarshalJSON() ([]byte, error) {
+	switch v.Kind {
+	case VarInt:
+		return []byte(strconv.FormatInt(v.Int, 10)), nil
+	case VarFloat:
+		return json.Marshal(v.Float)
+	case VarString:
+		return json.Marshal(v.String)
+	}
+	if len(v.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return v.Raw, nil
+}
+
+// VarMap is a set of chain variables by name
+type VarMap map[string]Var
+
+// UnmarshalJSON decodes an object of chain variables by name
+func (m *VarMap) UnmarshalJSON(data []byte) error {
+	var raw map[string]Var
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	*m = make(VarMap, len(raw))
+	for name, v := range raw {
+		v.Name = name
+		(*m)[name] = v
+	}
+	return nil
+}
+
+func (m VarMap) lookup(name string, kind VarKind) (Var, error) {
+	v, ok := m[name]
+	if !ok {
+		return Var{}, fmt.Errorf("helium: var %s: %w", name, ErrVarNotFound)
+	}
+	if v.Kind != kind && !(kind == VarFloat && v.Kind == VarInt) {
+		return Var{}, fmt.Errorf("helium: var %s is %s not %s: %w", name, v.Kind, kind, ErrVarKind)
+	}
+	return v, nil
+}
+
+// Int returns the value of an int var
+func (m VarMap) Int(name string) (int64, error) {
+	v, err := m.lookup(name, VarInt)
+	return v.Int, err
+}
+
+// Float returns the value of a float var, int vars are converted
+func (m VarMap) Float(name string) (float64, error) {
+	v, err := m.lookup(name, VarFloat)
+	if v.Kind == VarInt {
+		return float64(v.Int), err
+	}
+	return v.Float, err
+}
+
+// String returns the value of a string var
+func (m VarMap) String(name string) (string, error) {
+	v, err := m.lookup(name, VarString)
+	return v.String, err
+}
+
+// Binary returns the value of a binary var, which the api returns as a base64 string
+func (m VarMap) Binary(name string) ([]byte, error) {
+	v, err := m.lookup(name, VarString)
+	if err != nil {
+		return nil, err
+	}
+	b, err := base64.StdEncoding.DecodeString(v.String)
+	if err != nil {
+		return nil, fmt.Errorf("helium: var %s is not base64: %v: %w", name, err, ErrVarKind)
+	}
+	return b, nil
+}
+
+// PocChallengeInterval returns the number of blocks between the proof of coverage challenges of a hotspot
+func (m VarMap) PocChallengeInterval() (int, error) {
+	n, err := m.Int("poc_challenge_interval")
+	return int(n), err
+}
+
+// TxnFeeMultiplier returns the multiplier applied to the size based fee of every transaction
+func (m VarMap) TxnFeeMultiplier() (int, error) {
+	n, err := m.Int("txn_fee_multiplier")
+	return int(n), err
+}
+
+// ValidatorMinimumStake returns the stake required to run a validator
+func (m VarMap) ValidatorMinimumStake() (HNT, error) {
+	n, err := m.Int("validator_minimum_stake")
+	return HNT(n), err
+}
+
+// StakeWithdrawalCooldown returns the number of blocks an unstaked validator's stake is locked for
+func (m VarMap) StakeWithdrawalCooldown() (int, error) {
+	n, err := m.Int("stake_withdrawal_cooldown")
+	return int(n), err
+}
+
+// DcPayloadSize returns the number of packet bytes one data credit pays for
+func (m VarMap) DcPayloadSize() (int, error) {
+	n, err := m.Int("dc_payload_size")
+	return int(n), err
+}
+
+type ChainVars struct {
+	Data VarMap `json:"data"`
+}
+
+type ChainVar struct {
+	Data Var `json:"data"`
+}
+
+type VarActivity struct {
+	Data   Txns   `json:"data"`
+	Cursor string `json:"cursor"`
+}
+
+type VarActivityInput struct {
+	Cursor string
+}
+
+// List Returns every current chain variable.
+func (v *Vars) List() (*ChainVars, error) {
+	return v.ListWithContext(context.Background())
+}
+
+// ListWithContext is List with a caller supplied context.
+func (v *Vars) ListWithContext(ctx context.Context) (*ChainVars, error) {
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, "/vars", new(bytes.Buffer), nil)
+	if err != nil {
+		return &ChainVars{}, err
+	}
+	defer resp.Body.Close()
+
+	var chainVars *ChainVars
+	err = json.NewDecoder(resp.Body).Decode(&chainVars)
+	if err != nil {
+		return &ChainVars{}, err
+	}
+	return chainVars, nil
+}
+
+// Get Returns the current value of a chain variable.
+func (v *Vars) Get(name string) (*ChainVar, error) {
+	return v.GetWithContext(context.Background(), name)
+}
+
+// GetWithContext is Get with a caller supplied context.
+func (v *Vars) GetWithContext(ctx context.Context, name string) (*ChainVar, error) {
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, fmt.Sprintf("/vars/%s", name), new(bytes.Buffer), nil)
+	if err != nil {
+		return &ChainVar{}, err
+	}
+	defer resp.Body.Close()
+
+	var chainVar *ChainVar
+	err = json.NewDecoder(resp.Body).Decode(&chainVar)
+	if err != nil {
+		return &ChainVar{}, err
+	}
+	chainVar.Data.Name = name
+	return chainVar, nil
+}
+
+// Activity Lists the vars transactions that changed chain variables, newest first.
+func (v *Vars) Activity(input *VarActivityInput) (*VarActivity, error) {
+	return v.ActivityWithContext(context.Background(), input)
+}
+
+// ActivityWithContext is Activity with a caller supplied context.
+func (v *Vars) ActivityWithContext(ctx context.Context, input *VarActivityInput) (*VarActivity, error) {
+	params := make(map[string]string)
+	params["cursor"] = input.Cursor
+	resp, err := v.c.RequestContext(ctx, http.MethodGet, "/vars/activity", new(bytes.Buffer), params)
+	if err != nil {
+		return &VarActivity{}, err
+	}
+	defer resp.Body.Close()
+
+	var varActivity *VarActivity
+	err = json.NewDecoder(resp.Body).Decode(&varActivity)
+	if err != nil {
+		return &VarActivity{}, err
+	}
+	return varActivity, nil
+}
+
+// ActivityAll iterates over every vars transaction.
+func (v *Vars) ActivityAll(opts ...PagerOption) *VarActivityPager {
+	return NewVarActivityPager(func(ctx context.Context, cursor string) (*VarActivity, error) {
+		return v.ActivityWithContext(ctx, &VarActivityInput{Cursor: cursor})
+	}, opts...)
+}
@@ -0,0 +1,100 @@
+package helium
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testOracleKeys is price_oracle_public_keys as the api returns it, the binary keys of testAddress and
+// testAccount each prefixed with its length, base64 encoded
+const testOracleKeys = "IQDxFESSGHXi73Q1UTodHxsPpJ4yQpVqJDg5EuxdTxlAdyEBSjHR8yogI6HjabUS9pAwKQ2IF62nG/yKAtUrhdIHZ1w="
+
+const testVars = `{"poc_challenge_interval":480,"txn_fee_multiplier":5000,"validator_minimum_stake":1000000000000,` +
+	`"dc_percent":0.325,"predicate_callback_mod":"miner","price_oracle_public_keys":"` + testOracleKeys + `",` +
+	`"poc_path_limit":[1,2]}`
+
+func TestVarsList(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/vars", r.URL.Path)
+		w.Write([]byte(`{"data":` + testVars + `}`))
+	}))
+
+	vars, err := client.Vars().List()
+	assert.NoError(t, err)
+	interval, err := vars.Data.PocChallengeInterval()
+	assert.NoError(t, err)
+	assert.Equal(t, 480, interval)
+	multiplier, err := vars.Data.TxnFeeMultiplier()
+	assert.NoError(t, err)
+	assert.Equal(t, 5000, multiplier)
+	stake, err := vars.Data.ValidatorMinimumStake()
+	assert.NoError(t, err)
+	assert.Equal(t, HNT(10000*BonesPerHNT), stake)
+
+	percent, err := vars.Data.Float("dc_percent")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.325, percent)
+	// ints widen to floats
+	interval64, err := vars.Data.Float("poc_challenge_interval")
+	assert.NoError(t, err)
+	assert.Equal(t, 480.0, interval64)
+	mod, err := vars.Data.String("predicate_callback_mod")
+	assert.NoError(t, err)
+	assert.Equal(t, "miner", mod)
+	keys, err := vars.Data.String("price_oracle_public_keys")
+	assert.NoError(t, err)
+	assert.Equal(t, testOracleKeys, keys)
+	binary, err := vars.Data.Binary("price_oracle_public_keys")
+	assert.NoError(t, err)
+	var oracles [][]byte
+	for len(binary) > 0 && len(binary) > int(binary[0]) {
+		oracles = append(oracles, binary[1:1+binary[0]])
+		binary = binary[1+binary[0]:]
+	}
+	assert.Empty(t, binary)
+	assert.Equal(t, [][]byte{Address(testAddress).Bytes(), Address(testAccount).Bytes()}, oracles)
+	_, err = vars.Data.Binary("predicate_callback_mod")
+	assert.True(t, errors.Is(err, ErrVarKind))
+	_, err = vars.Data.Binary("dc_percent")
+	assert.True(t, errors.Is(err, ErrVarKind))
+	assert.Equal(t, VarOther, vars.Data["poc_path_limit"].Kind)
+
+	_, err = vars.Data.Int("dc_percent")
+	assert.True(t, errors.Is(err, ErrVarKind))
+	// a var missing from the map is not an api 404
+	_, err = vars.Data.DcPayloadSize()
+	assert.True(t, errors.Is(err, ErrVarNotFound))
+	assert.False(t, errors.Is(err, ErrNotFound))
+
+	out, err := json.Marshal(vars.Data)
+	assert.NoError(t, err)
+	assert.JSONEq(t, testVars, string(out))
+}
+
+func TestVarsGet(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/vars/price_oracle_public_keys":
+			w.Write([]byte(`{"data":"AQID"}`))
+		case "/vars/activity":
+			w.Write([]byte(`{"data":[{"type":"vars_v1","hash":"v1","height":5,"nonce":3,"vars":{"poc_challenge_interval":240}}]}`))
+		}
+	}))
+
+	v, err := client.Vars().Get("price_oracle_public_keys")
+	assert.NoError(t, err)
+	assert.Equal(t, "price_oracle_public_keys", v.Data.Name)
+	assert.Equal(t, VarString, v.Data.Kind)
+	assert.Equal(t, "AQID", v.Data.String)
+
+	activity, err := client.Vars().Activity(&VarActivityInput{})
+	assert.NoError(t, err)
+	txn := activity.Data[0].(*VarsV1)
+	interval, err := txn.Vars.PocChallengeInterval()
+	assert.NoError(t, err)
+	assert.Equal(t, 240, interval)
+}
@@ -0,0 +1,309 @@
+package helium
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/dougkirkley/helium-go/internal/base58"
+)
+
+// wallet kinds, the little endian u16 a helium-wallet file starts with
+const (
+	walletKindBasicV1   = 0x0001
+	walletKindBasicV2   = 0x0002
+	walletKindBasicV3   = 0x0003
+	walletKindShardedV1 = 0x0101
+	walletKindShardedV2 = 0x0102
+	walletKindShardedV3 = 0x0103
+)
+
+const (
+	// walletIterations is the PBKDF2 iteration count helium-wallet uses by default
+	walletIterations = 1000000
+	walletSaltSize   = 16
+	walletIVSize     = 12
+	walletTagSize    = 16
+	walletPubKeySize = 33
+	walletHeaderSize = 2 + walletSaltSize + 4 + walletPubKeySize + walletIVSize + walletTagSize
+)
+
+var (
+	// ErrWalletPassword is returned when a wallet can not be decrypted with the given password
+	ErrWalletPassword = errors.New("helium: incorrect wallet password")
+	// ErrWalletFormat is returned for wallet files of a kind that is not supported, such as sharded wallets
+	ErrWalletFormat = errors.New("helium: unsupported wallet format")
+)
+
+// Wallet is a keypair encrypted with a password, read from the binary file format of helium-wallet, the
+// official Helium CLI wallet, or from the wallet-v2 JSON form of the same fields. The key is encrypted with
+// AES-256-GCM under a key derived from the password with PBKDF2-SHA256, the public key is left in the clear
+// so a wallet can be identified without its password.
+//
+// Basic wallets of format versions 1 and 2 are supported, sharded wallets and wallets whose key is derived
+// with Argon2id return ErrWalletFormat.
+type Wallet struct {
+	Kind       uint16
+	Salt       []byte
+	Iterations uint32
+	PublicKey  []byte
+	IV         []byte
+	Tag        []byte
+	Encrypted  []byte
+}
+
+// Address returns the address of the key in the wallet
+func (w *Wallet) Address() Address {
+	return Address(base58.CheckEncode(0, w.PublicKey))
+}
+
+// EncryptWallet encrypts k with password as a basic version 2 wallet
+func EncryptWallet(k *Keypair, password string) (*Wallet, error) {
+	w := &Wallet{
+		Kind:       walletKindBasicV2,
+		Salt:       make([]byte, walletSaltSize),
+		Iterations: walletIterations,
+		PublicKey:  k.PublicKey(),
+		IV:         make([]byte, walletIVSize),
+	}
+	if len(w.PublicKey) != walletPubKeySize {
+		return nil, ErrKeyType
+	}
+	_, err := rand.Read(w.Salt)
+	if err != nil {
+		return nil, err
+	}
+	_, err = rand.Read(w.IV)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := w.aead(password)
+	if err != nil {
+		return nil, err
+	}
+	sealed := aead.Seal(nil, w.IV, k.Bytes(), w.PublicKey)
+	w.Encrypted, w.Tag = sealed[:len(sealed)-walletTagSize], sealed[len(sealed)-walletTagSize:]
+	return w, nil
+}
+
+// Decrypt returns the keypair in the wallet, ErrWalletPassword if password is wrong
+func (w *Wallet) Decrypt(password string) (*Keypair, error) {
+	if w.Kind != walletKindBasicV1 && w.Kind != walletKindBasicV2 {
+		return nil, fmt.Errorf("helium: wallet kind %#04x: %w", w.Kind, ErrWalletFormat)
+	}
+	if len(w.IV) != walletIVSize || len(w.Tag) != walletTagSize {
+		return nil, fmt.Errorf("helium: wallet iv or tag size: %w", ErrWalletFormat)
+	}
+	aead, err := w.aead(password)
+	if err != nil {
+		return nil, err
+	}
+	b, err := aead.Open(nil, w.IV, append(append([]byte{}, w.Encrypted...), w.Tag...), w.PublicKey)
+	if err != nil {
+		return nil, ErrWalletPassword
+	}
+	// version 1 wallets hold the bare ed25519 key, the tag byte is only in the public key
+	if len(b) == ed25519.PrivateKeySize && len(w.PublicKey) > 0 {
+		b = append([]byte{w.PublicKey[0]}, b...)
+	}
+	k, err := KeypairFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(k.PublicKey(), w.PublicKey) {
+		return nil, fmt.Errorf("helium: wallet key is for %s not %s", k.Address(), w.Address())
+	}
+	return k, nil
+}
+
+func (w *Wallet) aead(password string) (cipher.AEAD, error) {
+	if w.Iterations == 0 {
+		return nil, fmt.Errorf("helium: invalid wallet iterations %d", w.Iterations)
+	}
+	block, err := aes.NewCipher(pbkdf2SHA256([]byte(password), w.Salt, int(w.Iterations), 32))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// WriteWallet writes wallet to w in the helium-wallet file format
+func WriteWallet(w io.Writer, wallet *Wallet) error {
+	if len(wallet.Salt) != walletSaltSize || len(wallet.PublicKey) != walletPubKeySize ||
+		len(wallet.IV) != walletIVSize || len(wallet.Tag) != walletTagSize {
+		return fmt.Errorf("helium: wallet field sizes: %w", ErrWalletFormat)
+	}
+	b := make([]byte, 0, walletHeaderSize+len(wallet.Encrypted))
+	b = append(b, byte(wallet.Kind), byte(wallet.Kind>>8))
+	b = append(b, wallet.Salt...)
+	var iterations [4]byte
+	binary.LittleEndian.PutUint32(iterations[:], wallet.Iterations)
+	b = append(b, iterations[:]...)
+	b = append(b, wallet.PublicKey...)
+	b = append(b, wallet.IV...)
+	b = append(b, wallet.Tag...)
+	b = append(b, wallet.Encrypted...)
+	_, err := w.Write(b)
+	return err
+}
+
+// walletJSON is the wallet-v2 JSON form of a basic wallet, byte fields are base64 encoded
+type walletJSON struct {
+	Version    int     `json:"version"`
+	Address    Address `json:"address"`
+	KDF        string  `json:"kdf"`
+	Iterations uint32  `json:"iterations"`
+	Salt       []byte  `json:"salt"`
+	Cipher     string  `json:"cipher"`
+	IV         []byte  `json:"iv"`
+	Tag        []byte  `json:"tag"`
+	Ciphertext []byte  `json:"ciphertext"`
+}
+
+const (
+	walletKDF    = "pbkdf2-sha256"
+	walletCipher = "aes-256-gcm"
+)
+
+// MarshalJSON encodes a basic wallet in the wallet-v2 JSON form
+func (w *Wallet) MarshalJSON() ([]byte, error) {
+	if w.Kind != walletKindBasicV1 && w.Kind != walletKindBasicV2 {
+		return nil, fmt.Errorf("helium: wallet kind %#04x: %w", w.Kind, ErrWalletFormat)
+	}
+	return json.Marshal(walletJSON{
+		Version:    int(w.Kind),
+		Address:    w.Address(),
+		KDF:        walletKDF,
+		Iterations: w.Iterations,
+		Salt:       w.Salt,
+		Cipher:     walletCipher,
+		IV:         w.IV,
+		Tag:        w.Tag,
+		Ciphertext: w.Encrypted,
+	})
+}
+
+// UnmarshalJSON decodes a wallet in the wallet-v2 JSON form
+func (w *Wallet) UnmarshalJSON(data []byte) error {
+	var aux walletJSON
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+	if (aux.Version != walletKindBasicV1 && aux.Version != walletKindBasicV2) || aux.KDF != walletKDF || aux.Cipher != walletCipher {
+		return fmt.Errorf("helium: wallet version %d with %s and %s: %w", aux.Version, aux.KDF, aux.Cipher, ErrWalletFormat)
+	}
+	publicKey := aux.Address.Bytes()
+	if publicKey == nil {
+		return fmt.Errorf("helium: wallet address %q: %w", aux.Address, ErrWalletFormat)
+	}
+	*w = Wallet{
+		Kind:       uint16(aux.Version),
+		Salt:       aux.Salt,
+		Iterations: aux.Iterations,
+		PublicKey:  publicKey,
+		IV:         aux.IV,
+		Tag:        aux.Tag,
+		Encrypted:  aux.Ciphertext,
+	}
+	return nil
+}
+
+// WriteWalletJSON writes wallet to w in the wallet-v2 JSON form
+func WriteWalletJSON(w io.Writer, wallet *Wallet) error {
+	return json.NewEncoder(w).Encode(wallet)
+}
+
+// ReadWallet reads a wallet file written by helium-wallet or WriteWallet, or a wallet-v2 JSON wallet
+// written by WriteWalletJSON
+func ReadWallet(r io.Reader) (*Wallet, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '{' {
+		var w Wallet
+		err = json.Unmarshal(trimmed, &w)
+		if err != nil {
+			return nil, err
+		}
+		return &w, nil
+	}
+	if len(b) < 2 {
+		return nil, fmt.Errorf("helium: wallet is %d bytes: %w", len(b), ErrWalletFormat)
+	}
+	kind := binary.LittleEndian.Uint16(b)
+	switch kind {
+	case walletKindBasicV1, walletKindBasicV2:
+	case walletKindBasicV3:
+		return nil, fmt.Errorf("helium: argon2id wallets: %w", ErrWalletFormat)
+	case walletKindShardedV1, walletKindShardedV2, walletKindShardedV3:
+		return nil, fmt.Errorf("helium: sharded wallets: %w", ErrWalletFormat)
+	default:
+		return nil, fmt.Errorf("helium: wallet kind %#04x: %w", kind, ErrWalletFormat)
+	}
+	if len(b) <= walletHeaderSize {
+		return nil, fmt.Errorf("helium: wallet is %d bytes: %w", len(b), ErrWalletFormat)
+	}
+	w := &Wallet{Kind: kind}
+	b = b[2:]
+	w.Salt, b = b[:walletSaltSize], b[walletSaltSize:]
+	w.Iterations, b = binary.LittleEndian.Uint32(b), b[4:]
+	w.PublicKey, b = b[:walletPubKeySize], b[walletPubKeySize:]
+	w.IV, b = b[:walletIVSize], b[walletIVSize:]
+	w.Tag, w.Encrypted = b[:walletTagSize], b[walletTagSize:]
+	return w, nil
+}
+
+// SaveWallet writes wallet to the file at path, readable only by its owner
+func SaveWallet(path string, wallet *Wallet) error {
+	return saveFile(path, func(w io.Writer) error {
+		return WriteWallet(w, wallet)
+	})
+}
+
+// LoadWallet reads a wallet file, such as one created by helium-wallet
+func LoadWallet(path string) (*Wallet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadWallet(f)
+}
+
+// pbkdf2SHA256 derives a key of size bytes from password as in RFC 8018
+func pbkdf2SHA256(password, salt []byte, iterations, size int) []byte {
+	prf := hmac.New(sha256.New, password)
+	var key []byte
+	for block := uint32(1); len(key) < size; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		var counter [4]byte
+		binary.BigEndian.PutUint32(counter[:], block)
+		prf.Write(counter[:])
+		u := prf.Sum(nil)
+		t := append([]byte{}, u...)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+	return key[:size]
+}
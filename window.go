@@ -0,0 +1,129 @@
+package helium
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeBound is one end of the time window of a rewards or stats query, either an absolute time
+// from At or a time relative to now from Ago. Any value the api accepts can be used directly,
+// e.g. helium.TimeBound("-30 day").
+type TimeBound string
+
+// At bounds a time window at t
+func At(t time.Time) TimeBound {
+	return TimeBound(t.UTC().Format(time.RFC3339))
+}
+
+// agoUnits are the units Ago expresses a duration in, largest first
+var agoUnits = []struct {
+	unit time.Duration
+	name string
+}{
+	{24 * time.Hour, "day"},
+	{time.Hour, "hour"},
+	{time.Minute, "minute"},
+	{time.Second, "second"},
+}
+
+// Ago bounds a time window d before now, in the largest unit that d is a whole number of, e.g.
+// Ago(30*24*time.Hour) is "-30 day" and Ago(90*time.Minute) is "-90 minute". d is rounded to the second,
+// calendar units such as months can be given as a TimeBound directly.
+func Ago(d time.Duration) TimeBound {
+	d = d.Round(time.Second)
+	if d < 0 {
+		d = -d
+	}
+	for _, u := range agoUnits {
+		if d%u.unit == 0 && d >= u.unit {
+			return TimeBound(fmt.Sprintf("-%d %s", d/u.unit, u.name))
+		}
+	}
+	return TimeBound("-0 second")
+}
+
+// resolve returns the absolute time of the bound, relative bounds are taken from now. It accepts the forms
+// At and Ago produce, dates, and relative bounds in weeks, months and years, a zero bound resolves to the
+// zero time.
+func (b TimeBound) resolve(now time.Time) (time.Time, error) {
+	if b == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		t, err := time.Parse(layout, string(b))
+		if err == nil {
+			return t, nil
+		}
+	}
+	fields := strings.Fields(string(b))
+	if len(fields) == 2 {
+		n, err := strconv.Atoi(fields[0])
+		unit := strings.TrimSuffix(fields[1], "s")
+		if err == nil {
+			switch unit {
+			case "year":
+				return now.AddDate(n, 0, 0), nil
+			case "month":
+				return now.AddDate(0, n, 0), nil
+			case "week":
+				return now.AddDate(0, 0, 7*n), nil
+			}
+			for _, u := range agoUnits {
+				if u.name == unit {
+					return now.Add(time.Duration(n) * u.unit), nil
+				}
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("helium: cannot resolve time bound %q", b)
+}
+
+// Bucket is the size of the buckets a reward sum is split into
+type Bucket string
+
+const (
+	BucketHour Bucket = "hour"
+	BucketDay  Bucket = "day"
+	BucketWeek Bucket = "week"
+)
+
+// timeParams adds the bounds that are set to params
+func timeParams(params map[string]string, minTime, maxTime TimeBound) {
+	if minTime != "" {
+		params["min_time"] = string(minTime)
+	}
+	if maxTime != "" {
+		params["max_time"] = string(maxTime)
+	}
+}
+
+// bucketParams adds a time window split into buckets to params
+func bucketParams(params map[string]string, minTime, maxTime TimeBound, bucket Bucket) error {
+	switch bucket {
+	case BucketHour, BucketDay, BucketWeek:
+	default:
+		return fmt.Errorf("helium: invalid bucket %q", bucket)
+	}
+	timeParams(params, minTime, maxTime)
+	params["bucket"] = string(bucket)
+	return nil
+}
+
+type RewardBuckets struct {
+	Data []RewardBucket `json:"data"`
+	Meta Meta           `json:"meta"`
+}
+
+// RewardBucket is the rewards earned in one bucket of a reward sum, starting at Timestamp
+type RewardBucket struct {
+	Timestamp Timestamp `json:"timestamp"`
+	Sum       HNT       `json:"sum"`
+	Total     float64   `json:"total"`
+	Avg       float64   `json:"avg"`
+	Max       float64   `json:"max"`
+	Median    float64   `json:"median"`
+	Min       float64   `json:"min"`
+	Stddev    float64   `json:"stddev"`
+}
@@ -0,0 +1,87 @@
+package helium
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeBound(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	assert.Equal(t, TimeBound("2021-06-01T05:00:00Z"), At(time.Date(2021, 6, 1, 0, 0, 0, 0, est)))
+	assert.Equal(t, TimeBound("-30 day"), Ago(30*24*time.Hour))
+	assert.Equal(t, TimeBound("-7 day"), Ago(7*24*time.Hour))
+	assert.Equal(t, TimeBound("-90 minute"), Ago(90*time.Minute))
+	assert.Equal(t, TimeBound("-2 hour"), Ago(-2*time.Hour))
+	assert.Equal(t, TimeBound("-45 second"), Ago(45*time.Second+100*time.Millisecond))
+}
+
+func TestTimeBoundResolve(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	for bound, want := range map[TimeBound]time.Time{
+		"":                       {},
+		At(now.Add(-time.Hour)):  now.Add(-time.Hour),
+		"2021-05-01":             time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC),
+		Ago(30 * 24 * time.Hour): now.Add(-30 * 24 * time.Hour),
+		Ago(90 * time.Minute):    now.Add(-90 * time.Minute),
+		"-2 weeks":               now.AddDate(0, 0, -14),
+		"-1 month":               time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC),
+		"-1 year":                time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC),
+	} {
+		got, err := bound.resolve(now)
+		assert.NoError(t, err, bound)
+		assert.True(t, want.Equal(got), "%s: %v", bound, got)
+	}
+	for _, bound := range []TimeBound{"yesterday", "-1 fortnight", "-x day", "1 2 day"} {
+		_, err := bound.resolve(now)
+		assert.Error(t, err, bound)
+	}
+}
+
+func TestRewardSumTimeWindow(t *testing.T) {
+	var query url.Values
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"data":{"sum":"0","total":0}}`))
+	}))
+
+	_, err := client.Account().RewardSum(&AccountRewardsInput{ID: testAccount, MinTime: Ago(7 * 24 * time.Hour)})
+	assert.NoError(t, err)
+	assert.Equal(t, "-7 day", query.Get("min_time"))
+	_, ok := query["max_time"]
+	assert.False(t, ok)
+
+	min := At(time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC))
+	_, err = client.Hotspot().RewardSum(&HotspotRewardsInput{Address: testAddress, MinTime: min, MaxTime: "2021-06-01"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-05-01T00:00:00Z", query.Get("min_time"))
+	assert.Equal(t, "2021-06-01", query.Get("max_time"))
+}
+
+func TestRewardSumBuckets(t *testing.T) {
+	var query url.Values
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"data":[{"timestamp":"2021-06-02T00:00:00.000000Z","sum":150000000,"total":1.5,"max":1,"min":0.5},` +
+			`{"timestamp":"2021-06-01T00:00:00.000000Z","sum":0,"total":0}],` +
+			`"meta":{"min_time":"2021-06-01T00:00:00Z","max_time":"2021-06-03T00:00:00Z","bucket":"day"}}`))
+	}))
+
+	buckets, err := client.Validator().RewardsSumBuckets(testAddress, "2021-06-03", "2021-06-01", BucketDay)
+	assert.NoError(t, err)
+	assert.Equal(t, "day", query.Get("bucket"))
+	assert.Equal(t, "2021-06-01", query.Get("min_time"))
+	assert.Equal(t, BucketDay, buckets.Meta.Bucket)
+	assert.Len(t, buckets.Data, 2)
+	assert.Equal(t, HNT(150000000), buckets.Data[0].Sum)
+	assert.Equal(t, 1.5, buckets.Data[0].Total)
+	assert.True(t, buckets.Data[0].Timestamp.Equal(time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC)))
+
+	query = nil
+	_, err = client.Account().RewardSumBuckets(&AccountRewardsInput{ID: testAccount}, Bucket("month"))
+	assert.Error(t, err)
+	assert.Nil(t, query)
+}
@@ -0,0 +1,46 @@
+package helium
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram(t *testing.T) {
+	var h Histogram
+	assert.NoError(t, json.Unmarshal([]byte(`{"-132":0,"-124":1,"-116":6,"-108":2,"-100":1,"28":0}`), &h))
+	assert.Equal(t, 10, h.Count())
+	assert.Equal(t, 6, h[-116])
+	median, ok := h.Median()
+	assert.True(t, ok)
+	assert.Equal(t, -116, median)
+
+	_, ok = Histogram{-100: 0}.Median()
+	assert.False(t, ok)
+	median, _ = Histogram{-100: 1, -90: 1}.Median()
+	assert.Equal(t, -100, median)
+}
+
+func TestHotspotWitnesses(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/hotspots/"+testAddress+"/witnesses", r.URL.Path)
+		w.Write([]byte(`{"data":[{"address":"` + testAccount + `","name":"calm-fox","witness_for":"` + testAddress + `",` +
+			`"witness_info":{"first_time":1622505590123456789,"recent_time":1622505600123456789,` +
+			`"histogram":{"-116":3,"-108":1},"snr_histogram":{"-4":1,"5":3}}}]}`))
+	}))
+
+	witnesses, err := client.Hotspot().Witnesses(&HotspotInput{Address: testAddress})
+	assert.NoError(t, err)
+	info := witnesses.Data[0].WitnessInfo
+	assert.Equal(t, 4, info.Count())
+	assert.Equal(t, Histogram{-4: 1, 5: 3}, info.SnrHistogram)
+	rssi, _ := info.MedianRSSI()
+	assert.Equal(t, -116, rssi)
+	assert.True(t, info.FirstTime.Before(info.RecentTime.Time))
+	assert.Equal(t, map[Address]int{testAccount: 4}, witnesses.Counts())
+
+	_, err = client.Hotspot().Witnessed(&HotspotInput{Address: "bogus"})
+	assert.Error(t, err)
+}